@@ -26,6 +26,7 @@ import (
 
 type CustomFieldInterface interface {
 	GetRecordCustomFieldSchema(ctx context.Context, project *name.Project) (*commons.CustomFieldSchema, error)
+	GetMomentCustomFieldSchema(ctx context.Context, project *name.Project) (*commons.CustomFieldSchema, error)
 }
 
 type customFieldClient struct {
@@ -46,3 +47,14 @@ func (c *customFieldClient) GetRecordCustomFieldSchema(ctx context.Context, proj
 	}
 	return res.Msg, nil
 }
+
+func (c *customFieldClient) GetMomentCustomFieldSchema(ctx context.Context, project *name.Project) (*commons.CustomFieldSchema, error) {
+	req := connect.NewRequest(&openv1alpha1service.GetMomentCustomFieldSchemaRequest{
+		Project: project.String(),
+	})
+	res, err := c.customFieldServiceClient.GetMomentCustomFieldSchema(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg, nil
+}
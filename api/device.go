@@ -0,0 +1,129 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	openv1alpha1connect "buf.build/gen/go/coscene-io/coscene-openapi/connectrpc/go/coscene/openapi/dataplatform/v1alpha1/services/servicesconnect"
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/constants"
+	"github.com/coscene-io/cocli/internal/name"
+)
+
+type ListDevicesOptions struct {
+	// Project limits the listing to the devices of a project. When nil, all
+	// devices of the organization are listed.
+	Project *name.Project
+}
+
+type DeviceInterface interface {
+	// Get gets a device by name.
+	Get(ctx context.Context, deviceName *name.Device) (*openv1alpha1resource.Device, error)
+
+	// ListAll lists all devices of a project or of the organization.
+	ListAll(ctx context.Context, opts *ListDevicesOptions) ([]*openv1alpha1resource.Device, error)
+
+	// ResolveName resolves a device resource name, id or serial number to a device name.
+	ResolveName(ctx context.Context, device string) (*name.Device, error)
+}
+
+type deviceClient struct {
+	deviceServiceClient openv1alpha1connect.DeviceServiceClient
+}
+
+func NewDeviceClient(deviceServiceClient openv1alpha1connect.DeviceServiceClient) DeviceInterface {
+	return &deviceClient{
+		deviceServiceClient: deviceServiceClient,
+	}
+}
+
+func (c *deviceClient) Get(ctx context.Context, deviceName *name.Device) (*openv1alpha1resource.Device, error) {
+	req := connect.NewRequest(&openv1alpha1service.GetDeviceRequest{
+		Name: deviceName.String(),
+	})
+	res, err := c.deviceServiceClient.GetDevice(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+	return res.Msg, nil
+}
+
+func (c *deviceClient) ListAll(ctx context.Context, opts *ListDevicesOptions) ([]*openv1alpha1resource.Device, error) {
+	var (
+		skip = 0
+		ret  []*openv1alpha1resource.Device
+	)
+
+	for {
+		devices, err := c.listPage(ctx, opts, skip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list devices at skip %d: %w", skip, err)
+		}
+		if len(devices) == 0 {
+			break
+		}
+		ret = append(ret, devices...)
+		skip += constants.MaxPageSize
+	}
+
+	return ret, nil
+}
+
+func (c *deviceClient) listPage(ctx context.Context, opts *ListDevicesOptions, skip int) ([]*openv1alpha1resource.Device, error) {
+	if opts != nil && opts.Project != nil {
+		res, err := c.deviceServiceClient.ListProjectDevices(ctx, connect.NewRequest(&openv1alpha1service.ListProjectDevicesRequest{
+			Parent:   opts.Project.String(),
+			PageSize: constants.MaxPageSize,
+			Skip:     int32(skip),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg.Devices, nil
+	}
+
+	res, err := c.deviceServiceClient.ListOrganizationDevices(ctx, connect.NewRequest(&openv1alpha1service.ListOrganizationDevicesRequest{
+		PageSize: constants.MaxPageSize,
+		Skip:     int32(skip),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Devices, nil
+}
+
+func (c *deviceClient) ResolveName(ctx context.Context, device string) (*name.Device, error) {
+	if deviceName, err := name.NewDevice(device); err == nil {
+		return deviceName, nil
+	}
+	if name.IsUUID(device) {
+		return &name.Device{DeviceID: device}, nil
+	}
+
+	devices, err := c.ListAll(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range devices {
+		if d.GetSerialNumber() == device {
+			return name.NewDevice(d.GetName())
+		}
+	}
+	return nil, fmt.Errorf("device %q not found by id or serial number", device)
+}
@@ -21,12 +21,20 @@ import (
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
 	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/name"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type EventInterface interface {
 	// ObtainEvent creates an event if not found, fetches otherwise.
 	ObtainEvent(ctx context.Context, parent string, event *openv1alpha1resource.Event) (*openv1alpha1service.ObtainEventResponse, error)
+
+	// UpdateEvent updates the fields of the event listed in paths.
+	UpdateEvent(ctx context.Context, event *openv1alpha1resource.Event, paths []string) (*openv1alpha1resource.Event, error)
+
+	// DeleteEvent deletes an event by name.
+	DeleteEvent(ctx context.Context, eventName *name.Event) error
 }
 
 type eventClient struct {
@@ -51,3 +59,27 @@ func (c *eventClient) ObtainEvent(ctx context.Context, parent string, event *ope
 
 	return createEventRes.Msg, nil
 }
+
+func (c *eventClient) UpdateEvent(ctx context.Context, event *openv1alpha1resource.Event, paths []string) (*openv1alpha1resource.Event, error) {
+	req := connect.NewRequest(&openv1alpha1service.UpdateEventRequest{
+		Event: event,
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: paths,
+		},
+	})
+	res, err := c.eventClient.UpdateEvent(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update event %s", event.Name)
+	}
+	return res.Msg, nil
+}
+
+func (c *eventClient) DeleteEvent(ctx context.Context, eventName *name.Event) error {
+	req := connect.NewRequest(&openv1alpha1service.DeleteEventRequest{
+		Name: eventName.String(),
+	})
+	if _, err := c.eventClient.DeleteEvent(ctx, req); err != nil {
+		return errors.Wrapf(err, "failed to delete event %s", eventName)
+	}
+	return nil
+}
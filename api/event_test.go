@@ -0,0 +1,93 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	openv1alpha1connect "buf.build/gen/go/coscene-io/coscene-openapi/connectrpc/go/coscene/openapi/dataplatform/v1alpha1/services/servicesconnect"
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mockEventServiceClient struct {
+	openv1alpha1connect.EventServiceClient
+
+	updateEventFunc func(context.Context, *connect.Request[openv1alpha1service.UpdateEventRequest]) (*connect.Response[openv1alpha1resource.Event], error)
+	deleteEventFunc func(context.Context, *connect.Request[openv1alpha1service.DeleteEventRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+func (m *mockEventServiceClient) UpdateEvent(ctx context.Context, req *connect.Request[openv1alpha1service.UpdateEventRequest]) (*connect.Response[openv1alpha1resource.Event], error) {
+	if m.updateEventFunc != nil {
+		return m.updateEventFunc(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
+func (m *mockEventServiceClient) DeleteEvent(ctx context.Context, req *connect.Request[openv1alpha1service.DeleteEventRequest]) (*connect.Response[emptypb.Empty], error) {
+	if m.deleteEventFunc != nil {
+		return m.deleteEventFunc(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
+func TestEventClient_UpdateEvent(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	event := &openv1alpha1resource.Event{
+		Name:        "projects/p1/events/e1",
+		DisplayName: "hard brake",
+	}
+
+	client := NewEventClient(&mockEventServiceClient{
+		updateEventFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.UpdateEventRequest]) (*connect.Response[openv1alpha1resource.Event], error) {
+			assert.Equal(t, "projects/p1/events/e1", req.Msg.Event.Name)
+			assert.Equal(t, []string{"display_name"}, req.Msg.UpdateMask.Paths)
+			return connect.NewResponse(req.Msg.Event), nil
+		},
+	})
+
+	updated, err := client.UpdateEvent(ctx, event, []string{"display_name"})
+	require.NoError(t, err)
+	assert.Equal(t, "hard brake", updated.DisplayName)
+}
+
+func TestEventClient_DeleteEvent(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	eventName := &name.Event{ProjectID: "p1", EventID: "e1"}
+
+	t.Run("success", func(t *testing.T) {
+		client := NewEventClient(&mockEventServiceClient{
+			deleteEventFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.DeleteEventRequest]) (*connect.Response[emptypb.Empty], error) {
+				assert.Equal(t, "projects/p1/events/e1", req.Msg.Name)
+				return connect.NewResponse(&emptypb.Empty{}), nil
+			},
+		})
+		require.NoError(t, client.DeleteEvent(ctx, eventName))
+	})
+
+	t.Run("error keeps the connect code", func(t *testing.T) {
+		client := NewEventClient(&mockEventServiceClient{})
+		err := client.DeleteEvent(ctx, eventName)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	})
+}
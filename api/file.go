@@ -22,6 +22,7 @@ import (
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
 	"connectrpc.com/connect"
+	"github.com/samber/lo"
 )
 
 type FileInterface interface {
@@ -39,6 +40,9 @@ type FileInterface interface {
 
 	// BatchDeleteFiles deletes multiple files under a parent.
 	BatchDeleteFiles(ctx context.Context, parent string, names []string) error
+
+	// CopyFiles copies files between two parents, each a record or a project, on the server side.
+	CopyFiles(ctx context.Context, parent string, destination string, pairs []FileCopyPair) error
 }
 
 type fileClient struct {
@@ -109,3 +113,20 @@ func (c *fileClient) BatchDeleteFiles(ctx context.Context, parent string, names
 	}
 	return nil
 }
+
+func (c *fileClient) CopyFiles(ctx context.Context, parent string, destination string, pairs []FileCopyPair) error {
+	req := connect.NewRequest(&openv1alpha1service.CopyFilesRequest{
+		Parent:      parent,
+		Destination: destination,
+		CopyPairs: lo.Map(pairs, func(pair FileCopyPair, _ int) *openv1alpha1service.CopyFilesRequest_CopyPair {
+			return &openv1alpha1service.CopyFilesRequest_CopyPair{
+				SrcFile: pair.Src,
+				DstFile: pair.Dst,
+			}
+		}),
+	})
+	// TODO: The matrix server did not handle the copied files in the response correctly.
+	// 	 We will be able to check the Files field after the server is updated.
+	_, err := c.fileServiceClient.CopyFiles(ctx, req)
+	return err
+}
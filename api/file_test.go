@@ -41,6 +41,7 @@ type mockFileServiceClientForFileTest struct {
 	generateFileDownloadUrlFunc func(context.Context, *connect.Request[openv1alpha1service.GenerateFileDownloadURLRequest]) (*connect.Response[openv1alpha1service.GenerateFileDownloadURLResponse], error)
 	deleteFileFunc              func(context.Context, *connect.Request[openv1alpha1service.DeleteFileRequest]) (*connect.Response[emptypb.Empty], error)
 	batchDeleteFilesFunc        func(context.Context, *connect.Request[openv1alpha1service.BatchDeleteFilesRequest]) (*connect.Response[emptypb.Empty], error)
+	copyFilesFunc               func(context.Context, *connect.Request[openv1alpha1service.CopyFilesRequest]) (*connect.Response[openv1alpha1service.CopyFilesResponse], error)
 }
 
 func (m *mockFileServiceClientForFileTest) GetFile(ctx context.Context, req *connect.Request[openv1alpha1service.GetFileRequest]) (*connect.Response[openv1alpha1resource.File], error) {
@@ -78,6 +79,13 @@ func (m *mockFileServiceClientForFileTest) BatchDeleteFiles(ctx context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, nil)
 }
 
+func (m *mockFileServiceClientForFileTest) CopyFiles(ctx context.Context, req *connect.Request[openv1alpha1service.CopyFilesRequest]) (*connect.Response[openv1alpha1service.CopyFilesResponse], error) {
+	if m.copyFilesFunc != nil {
+		return m.copyFilesFunc(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
 func TestFileClient_GetFile(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	ctrl := gomock.NewController(t)
@@ -217,6 +225,44 @@ func TestFileClient_BatchDeleteFiles(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestFileClient_CopyFiles(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	parent := "projects/test-project"
+	destination := "projects/test-project/records/test-record"
+	pairs := []FileCopyPair{
+		{Src: "calib/camera.yaml", Dst: "calib/camera.yaml"},
+		{Src: "calib/lidar.yaml", Dst: "lidar.yaml"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockFileService := &mockFileServiceClientForFileTest{
+			ctrl: ctrl,
+			copyFilesFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.CopyFilesRequest]) (*connect.Response[openv1alpha1service.CopyFilesResponse], error) {
+				assert.Equal(t, parent, req.Msg.Parent)
+				assert.Equal(t, destination, req.Msg.Destination)
+				require.Len(t, req.Msg.CopyPairs, 2)
+				assert.Equal(t, "calib/lidar.yaml", req.Msg.CopyPairs[1].SrcFile)
+				assert.Equal(t, "lidar.yaml", req.Msg.CopyPairs[1].DstFile)
+				return connect.NewResponse(&openv1alpha1service.CopyFilesResponse{}), nil
+			},
+		}
+
+		client := NewFileClient(mockFileService)
+		require.NoError(t, client.CopyFiles(ctx, parent, destination, pairs))
+	})
+
+	t.Run("keeps the connect error code", func(t *testing.T) {
+		client := NewFileClient(&mockFileServiceClientForFileTest{ctrl: ctrl})
+
+		err := client.CopyFiles(ctx, parent, destination, pairs)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	})
+}
+
 func TestFileClient_ErrorHandling(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	ctrl := gomock.NewController(t)
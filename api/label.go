@@ -23,13 +23,30 @@ import (
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
 	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/constants"
 	"github.com/coscene-io/cocli/internal/name"
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/protobuf/field_mask"
 )
 
 type LabelInterface interface {
 	// GetByDisplayNameOrCreate gets a label by display name, creates it if not found.
 	GetByDisplayNameOrCreate(ctx context.Context, displayName string, projectName *name.Project) (*openv1alpha1resource.Label, error)
+
+	// GetByDisplayName gets a label by display name, returns a NotFound error if it does not exist.
+	GetByDisplayName(ctx context.Context, displayName string, projectName *name.Project) (*openv1alpha1resource.Label, error)
+
+	// ListAll lists all labels in a project.
+	ListAll(ctx context.Context, projectName *name.Project) ([]*openv1alpha1resource.Label, error)
+
+	// Create creates a label.
+	Create(ctx context.Context, displayName string, projectName *name.Project) (*openv1alpha1resource.Label, error)
+
+	// Rename changes the display name of a label.
+	Rename(ctx context.Context, labelName string, displayName string) (*openv1alpha1resource.Label, error)
+
+	// Delete deletes a label by resource name.
+	Delete(ctx context.Context, labelName string) error
 }
 
 type labelClient struct {
@@ -67,3 +84,87 @@ func (c *labelClient) GetByDisplayNameOrCreate(ctx context.Context, displayName
 	}
 	return createLabelRes.Msg, nil
 }
+
+func (c *labelClient) GetByDisplayName(ctx context.Context, displayName string, project *name.Project) (*openv1alpha1resource.Label, error) {
+	req := connect.NewRequest(&openv1alpha1service.ListLabelsRequest{
+		Parent:   project.String(),
+		PageSize: 10,
+		Skip:     0,
+		Filter:   fmt.Sprintf("display_name=%s", strconv.Quote(displayName)),
+	})
+	res, err := c.labelServiceClient.ListLabels(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list label %s failed", displayName)
+	}
+	for _, label := range res.Msg.Labels {
+		if label.DisplayName == displayName {
+			return label, nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("label %s not found in %s", displayName, project))
+}
+
+func (c *labelClient) ListAll(ctx context.Context, project *name.Project) ([]*openv1alpha1resource.Label, error) {
+	var (
+		skip = 0
+		ret  []*openv1alpha1resource.Label
+	)
+
+	for {
+		req := connect.NewRequest(&openv1alpha1service.ListLabelsRequest{
+			Parent:   project.String(),
+			PageSize: constants.MaxPageSize,
+			Skip:     int32(skip),
+		})
+		res, err := c.labelServiceClient.ListLabels(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list labels at skip %d: %w", skip, err)
+		}
+		ret = append(ret, res.Msg.Labels...)
+		skip += constants.MaxPageSize
+		if len(res.Msg.Labels) < constants.MaxPageSize || int64(skip) >= res.Msg.TotalSize {
+			break
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *labelClient) Create(ctx context.Context, displayName string, project *name.Project) (*openv1alpha1resource.Label, error) {
+	req := connect.NewRequest(&openv1alpha1service.CreateLabelRequest{
+		Parent: project.String(),
+		Label: &openv1alpha1resource.Label{
+			DisplayName: displayName,
+		},
+	})
+	res, err := c.labelServiceClient.CreateLabel(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create label %s failed", displayName)
+	}
+	return res.Msg, nil
+}
+
+func (c *labelClient) Rename(ctx context.Context, labelName string, displayName string) (*openv1alpha1resource.Label, error) {
+	req := connect.NewRequest(&openv1alpha1service.UpdateLabelRequest{
+		Label: &openv1alpha1resource.Label{
+			Name:        labelName,
+			DisplayName: displayName,
+		},
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{"display_name"},
+		},
+	})
+	res, err := c.labelServiceClient.UpdateLabel(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "rename label %s failed", labelName)
+	}
+	return res.Msg, nil
+}
+
+func (c *labelClient) Delete(ctx context.Context, labelName string) error {
+	req := connect.NewRequest(&openv1alpha1service.DeleteLabelRequest{
+		Name: labelName,
+	})
+	_, err := c.labelServiceClient.DeleteLabel(ctx, req)
+	return err
+}
@@ -16,6 +16,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	openv1alpha1connect "buf.build/gen/go/coscene-io/coscene-openapi/connectrpc/go/coscene/openapi/dataplatform/v1alpha1/services/servicesconnect"
@@ -27,6 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // mockLabelServiceClientForTest is a mock implementation for label tests
@@ -36,6 +38,8 @@ type mockLabelServiceClientForTest struct {
 
 	listLabelsFunc  func(context.Context, *connect.Request[openv1alpha1service.ListLabelsRequest]) (*connect.Response[openv1alpha1service.ListLabelsResponse], error)
 	createLabelFunc func(context.Context, *connect.Request[openv1alpha1service.CreateLabelRequest]) (*connect.Response[openv1alpha1resource.Label], error)
+	updateLabelFunc func(context.Context, *connect.Request[openv1alpha1service.UpdateLabelRequest]) (*connect.Response[openv1alpha1resource.Label], error)
+	deleteLabelFunc func(context.Context, *connect.Request[openv1alpha1service.DeleteLabelRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 func (m *mockLabelServiceClientForTest) ListLabels(ctx context.Context, req *connect.Request[openv1alpha1service.ListLabelsRequest]) (*connect.Response[openv1alpha1service.ListLabelsResponse], error) {
@@ -52,6 +56,20 @@ func (m *mockLabelServiceClientForTest) CreateLabel(ctx context.Context, req *co
 	return nil, connect.NewError(connect.CodeUnimplemented, nil)
 }
 
+func (m *mockLabelServiceClientForTest) UpdateLabel(ctx context.Context, req *connect.Request[openv1alpha1service.UpdateLabelRequest]) (*connect.Response[openv1alpha1resource.Label], error) {
+	if m.updateLabelFunc != nil {
+		return m.updateLabelFunc(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
+func (m *mockLabelServiceClientForTest) DeleteLabel(ctx context.Context, req *connect.Request[openv1alpha1service.DeleteLabelRequest]) (*connect.Response[emptypb.Empty], error) {
+	if m.deleteLabelFunc != nil {
+		return m.deleteLabelFunc(ctx, req)
+	}
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
 func TestLabelClient_GetByDisplayNameOrCreate(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	ctrl := gomock.NewController(t)
@@ -186,3 +204,118 @@ func TestLabelClient_GetByDisplayNameOrCreate(t *testing.T) {
 		assert.Equal(t, connect.CodeOf(err), connect.CodeInternal)
 	})
 }
+
+func TestLabelClient_GetByDisplayName(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectName := &name.Project{ProjectID: "test-project"}
+	existingLabel := &openv1alpha1resource.Label{
+		Name:        "projects/test-project/labels/label-123",
+		DisplayName: "highway",
+	}
+	mockLabelService := &mockLabelServiceClientForTest{
+		ctrl: ctrl,
+		listLabelsFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.ListLabelsRequest]) (*connect.Response[openv1alpha1service.ListLabelsResponse], error) {
+			if req.Msg.Filter == `display_name="highway"` {
+				return connect.NewResponse(&openv1alpha1service.ListLabelsResponse{
+					Labels:    []*openv1alpha1resource.Label{existingLabel},
+					TotalSize: 1,
+				}), nil
+			}
+			return connect.NewResponse(&openv1alpha1service.ListLabelsResponse{}), nil
+		},
+		createLabelFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.CreateLabelRequest]) (*connect.Response[openv1alpha1resource.Label], error) {
+			t.Fatal("GetByDisplayName must not create labels")
+			return nil, nil
+		},
+	}
+
+	client := NewLabelClient(mockLabelService)
+
+	label, err := client.GetByDisplayName(ctx, "highway", projectName)
+	require.NoError(t, err)
+	assert.Equal(t, existingLabel, label)
+
+	_, err = client.GetByDisplayName(ctx, "higway", projectName)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestLabelClient_ListAll(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectName := &name.Project{ProjectID: "test-project"}
+	firstPage := make([]*openv1alpha1resource.Label, 100)
+	for i := range firstPage {
+		firstPage[i] = testutil.CreateTestLabel(fmt.Sprintf("label-%d", i))
+	}
+	lastLabel := testutil.CreateTestLabel("label-100")
+
+	mockLabelService := &mockLabelServiceClientForTest{
+		ctrl: ctrl,
+		listLabelsFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.ListLabelsRequest]) (*connect.Response[openv1alpha1service.ListLabelsResponse], error) {
+			assert.Equal(t, projectName.String(), req.Msg.Parent)
+			switch req.Msg.Skip {
+			case 0:
+				return connect.NewResponse(&openv1alpha1service.ListLabelsResponse{Labels: firstPage, TotalSize: 101}), nil
+			case 100:
+				return connect.NewResponse(&openv1alpha1service.ListLabelsResponse{Labels: []*openv1alpha1resource.Label{lastLabel}, TotalSize: 101}), nil
+			default:
+				t.Fatalf("unexpected skip %d", req.Msg.Skip)
+				return nil, nil
+			}
+		},
+	}
+
+	client := NewLabelClient(mockLabelService)
+
+	labels, err := client.ListAll(ctx, projectName)
+	require.NoError(t, err)
+	assert.Len(t, labels, 101)
+	assert.Equal(t, lastLabel, labels[100])
+}
+
+func TestLabelClient_Rename(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	labelName := "projects/test-project/labels/label-123"
+	mockLabelService := &mockLabelServiceClientForTest{
+		ctrl: ctrl,
+		updateLabelFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.UpdateLabelRequest]) (*connect.Response[openv1alpha1resource.Label], error) {
+			assert.Equal(t, labelName, req.Msg.Label.Name)
+			assert.Equal(t, "motorway", req.Msg.Label.DisplayName)
+			assert.Equal(t, []string{"display_name"}, req.Msg.UpdateMask.Paths)
+			return connect.NewResponse(req.Msg.Label), nil
+		},
+	}
+
+	client := NewLabelClient(mockLabelService)
+
+	label, err := client.Rename(ctx, labelName, "motorway")
+	require.NoError(t, err)
+	assert.Equal(t, "motorway", label.DisplayName)
+}
+
+func TestLabelClient_Delete(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	labelName := "projects/test-project/labels/label-123"
+	mockLabelService := &mockLabelServiceClientForTest{
+		ctrl: ctrl,
+		deleteLabelFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.DeleteLabelRequest]) (*connect.Response[emptypb.Empty], error) {
+			assert.Equal(t, labelName, req.Msg.Name)
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+	}
+
+	client := NewLabelClient(mockLabelService)
+	require.NoError(t, client.Delete(ctx, labelName))
+}
@@ -17,6 +17,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,6 +51,9 @@ type RecordInterface interface {
 	// CopyFiles copies files from src record to dst record.
 	CopyFiles(ctx context.Context, srcRecordName *name.Record, dstRecordName *name.Record, files []*openv1alpha1resource.File) error
 
+	// CopyFilePairs copies files from src record to dst record under new paths.
+	CopyFilePairs(ctx context.Context, srcRecordName *name.Record, dstRecordName *name.Record, pairs []FileCopyPair) error
+
 	// MoveFiles moves files from src record to dst record.
 	MoveFiles(ctx context.Context, srcRecordName *name.Record, dstRecordName *name.Record, files []*openv1alpha1resource.File) error
 
@@ -74,12 +78,18 @@ type RecordInterface interface {
 	// Update updates a record.
 	Update(ctx context.Context, recordName *name.Record, title string, description string, labels []*openv1alpha1resource.Label, customFieldValues []*commons.CustomFieldValue, fieldMask []string) error
 
+	// SetArchived archives or unarchives a record.
+	SetArchived(ctx context.Context, recordName *name.Record, archived bool) error
+
 	// ListAllEvents lists all events in a record.
 	ListAllEvents(ctx context.Context, recordName *name.Record) ([]*openv1alpha1resource.Event, error)
 
 	// ListAllMoments lists all moments in a record.
 	ListAllMoments(ctx context.Context, recordName *name.Record) ([]*Moment, error)
 
+	// EventsToMoments converts events to moments, resolving custom field values.
+	EventsToMoments(ctx context.Context, events []*openv1alpha1resource.Event) ([]*Moment, error)
+
 	// SearchAll searches all records in a project using the new SearchRecords API.
 	SearchAll(ctx context.Context, options *SearchRecordsOptions) ([]*openv1alpha1resource.Record, error)
 
@@ -102,6 +112,18 @@ type SearchRecordsOptions struct {
 	PageSize       int32
 	PageToken      string
 	OrderBy        string
+
+	// CreatedAfter, CreatedBefore and UpdatedSince are ignored when zero.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedSince  time.Time
+	// CreatorIDs and DeviceIDs are bare user and device ids.
+	CreatorIDs []string
+	DeviceIDs  []string
+	// CustomFields are matched exactly, as resolved against the project schema.
+	CustomFields []*commons.CustomFieldValue
+	// HasFiles are path globs, each of which must match a file in the record.
+	HasFiles []string
 }
 
 type SearchRecordsResult struct {
@@ -117,6 +139,13 @@ type recordClient struct {
 	labelServiceClient  openv1alpha1connect.LabelServiceClient
 }
 
+// FileCopyPair is a file copied from the Src path of one record to the Dst
+// path of another (or the same) record.
+type FileCopyPair struct {
+	Src string
+	Dst string
+}
+
 type Moment struct {
 	Name              string            `json:"name"`
 	Description       string            `json:"description"`
@@ -188,34 +217,14 @@ func (c *recordClient) Copy(ctx context.Context, recordName *name.Record, target
 }
 
 func (c *recordClient) CopyFiles(ctx context.Context, srcRecordName *name.Record, dstRecordName *name.Record, files []*openv1alpha1resource.File) error {
-	copyPairs := lo.Map(files, func(file *openv1alpha1resource.File, _ int) *openv1alpha1service.CopyFilesRequest_CopyPair {
-		return &openv1alpha1service.CopyFilesRequest_CopyPair{
-			SrcFile: file.GetFilename(),
-			DstFile: file.GetFilename(),
-		}
-	})
-
-	req := connect.NewRequest(&openv1alpha1service.CopyFilesRequest{
-		Parent:      srcRecordName.String(),
-		Destination: dstRecordName.String(),
-		CopyPairs:   copyPairs,
+	pairs := lo.Map(files, func(file *openv1alpha1resource.File, _ int) FileCopyPair {
+		return FileCopyPair{Src: file.GetFilename(), Dst: file.GetFilename()}
 	})
+	return c.CopyFilePairs(ctx, srcRecordName, dstRecordName, pairs)
+}
 
-	res, err := c.fileServiceClient.CopyFiles(ctx, req)
-	if err != nil {
-		return err
-	}
-	// TODO: The matrix server did not handle the copied files in the response correctly.
-	// 	 We will be able to check the Files field after the server is updated.
-	if res.Msg != nil {
-		if res.Msg.Files != nil && len(res.Msg.Files) == len(files) {
-			// Server returned copied files in response (ideal case)
-			return nil
-		}
-		// Server did not return copied files in response (current behavior)
-		return nil
-	}
-	return nil
+func (c *recordClient) CopyFilePairs(ctx context.Context, srcRecordName *name.Record, dstRecordName *name.Record, pairs []FileCopyPair) error {
+	return NewFileClient(c.fileServiceClient).CopyFiles(ctx, srcRecordName.String(), dstRecordName.String(), pairs)
 }
 
 func (c *recordClient) Move(ctx context.Context, recordName *name.Record, targetProjectName *name.Project) (*openv1alpha1resource.Record, error) {
@@ -371,6 +380,20 @@ func (c *recordClient) Update(ctx context.Context, recordName *name.Record, titl
 	return err
 }
 
+func (c *recordClient) SetArchived(ctx context.Context, recordName *name.Record, archived bool) error {
+	req := connect.NewRequest(&openv1alpha1service.UpdateRecordRequest{
+		Record: &openv1alpha1resource.Record{
+			Name:       recordName.String(),
+			IsArchived: archived,
+		},
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{"is_archived"},
+		},
+	})
+	_, err := c.recordServiceClient.UpdateRecord(ctx, req)
+	return err
+}
+
 func (c *recordClient) ListAllEvents(ctx context.Context, recordName *name.Record) ([]*openv1alpha1resource.Event, error) {
 	var (
 		skip = 0
@@ -403,7 +426,10 @@ func (c *recordClient) ListAllMoments(ctx context.Context, recordName *name.Reco
 	if err != nil {
 		return nil, err
 	}
+	return c.EventsToMoments(ctx, events)
+}
 
+func (c *recordClient) EventsToMoments(ctx context.Context, events []*openv1alpha1resource.Event) ([]*Moment, error) {
 	users := []string{}
 	var name2User map[string]*openv1alpha1resource.User
 	lo.ForEach(events, func(event *openv1alpha1resource.Event, _ int) {
@@ -582,9 +608,70 @@ func (c *recordClient) buildSearchFilter(ctx context.Context, opts *SearchRecord
 		})
 		filters = append(filters, fmt.Sprintf("relatedLabels.id in [%s]", strings.Join(quotedIDs, ", ")))
 	}
+	if !opts.CreatedAfter.IsZero() {
+		filters = append(filters, fmt.Sprintf("createTime >= %q", opts.CreatedAfter.UTC().Format(time.RFC3339)))
+	}
+	if !opts.CreatedBefore.IsZero() {
+		filters = append(filters, fmt.Sprintf("createTime < %q", opts.CreatedBefore.UTC().Format(time.RFC3339)))
+	}
+	if !opts.UpdatedSince.IsZero() {
+		filters = append(filters, fmt.Sprintf("updateTime >= %q", opts.UpdatedSince.UTC().Format(time.RFC3339)))
+	}
+	if len(opts.CreatorIDs) > 0 {
+		filters = append(filters, fmt.Sprintf("creator.id in [%s]", quoteJoin(opts.CreatorIDs)))
+	}
+	if len(opts.DeviceIDs) > 0 {
+		filters = append(filters, fmt.Sprintf("device.id in [%s]", quoteJoin(opts.DeviceIDs)))
+	}
+	for _, cfv := range opts.CustomFields {
+		filter, err := customFieldFilter(cfv)
+		if err != nil {
+			return "", err
+		}
+		filters = append(filters, filter)
+	}
+	for _, glob := range opts.HasFiles {
+		filters = append(filters, fmt.Sprintf("files.path = %q", glob))
+	}
 	return strings.Join(filters, " AND "), nil
 }
 
+// customFieldFilter compiles a resolved custom field value into an AIP-160
+// restriction. Multi-valued enums and users must contain every given id.
+func customFieldFilter(cfv *commons.CustomFieldValue) (string, error) {
+	field := fmt.Sprintf("customFieldValues.%s", cfv.GetProperty().GetName())
+	hasAll := func(ids []string) string {
+		return strings.Join(lo.Map(ids, func(id string, _ int) string {
+			return fmt.Sprintf("%s : %q", field, id)
+		}), " AND ")
+	}
+
+	switch v := cfv.GetValue().(type) {
+	case *commons.CustomFieldValue_Text:
+		return fmt.Sprintf("%s = %q", field, v.Text.GetValue()), nil
+	case *commons.CustomFieldValue_Number:
+		return fmt.Sprintf("%s = %s", field, strconv.FormatFloat(v.Number.GetValue(), 'f', -1, 64)), nil
+	case *commons.CustomFieldValue_Enums:
+		if len(v.Enums.GetIds()) > 0 {
+			return hasAll(v.Enums.GetIds()), nil
+		}
+		return fmt.Sprintf("%s = %q", field, v.Enums.GetId()), nil
+	case *commons.CustomFieldValue_Time:
+		return fmt.Sprintf("%s = %q", field, v.Time.GetValue().AsTime().UTC().Format(time.RFC3339)), nil
+	case *commons.CustomFieldValue_User:
+		return hasAll(v.User.GetIds()), nil
+	default:
+		return "", fmt.Errorf("unsupported custom field type for %s", cfv.GetProperty().GetName())
+	}
+}
+
+// quoteJoin quotes each value and joins them for an AIP-160 list literal.
+func quoteJoin(values []string) string {
+	return strings.Join(lo.Map(values, func(v string, _ int) string {
+		return fmt.Sprintf("%q", v)
+	}), ", ")
+}
+
 func (c *recordClient) GenerateRecordThumbnailUploadUrl(ctx context.Context, recordName *name.Record) (string, error) {
 	req := connect.NewRequest(&openv1alpha1service.GenerateRecordThumbnailUploadUrlRequest{
 		Record: recordName.String(),
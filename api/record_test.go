@@ -17,7 +17,9 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
+	"buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
 	"connectrpc.com/connect"
@@ -27,7 +29,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestRecordClient_Get(t *testing.T) {
@@ -155,6 +159,32 @@ func TestRecordClient_Update(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRecordClient_SetArchived(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	recordName := &name.Record{
+		ProjectID: "test-project",
+		RecordID:  "test-record",
+	}
+
+	for _, archived := range []bool{true, false} {
+		mockRecordService := &mockRecordServiceClient{
+			ctrl: ctrl,
+			updateRecordFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.UpdateRecordRequest]) (*connect.Response[openv1alpha1resource.Record], error) {
+				assert.Equal(t, recordName.String(), req.Msg.Record.Name)
+				assert.Equal(t, archived, req.Msg.Record.IsArchived)
+				assert.Equal(t, []string{"is_archived"}, req.Msg.UpdateMask.Paths)
+				return connect.NewResponse(&openv1alpha1resource.Record{}), nil
+			},
+		}
+
+		client := NewRecordClient(mockRecordService, nil, nil, nil)
+		require.NoError(t, client.SetArchived(ctx, recordName, archived))
+	}
+}
+
 func TestRecordClient_ListAllFiles(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	ctrl := gomock.NewController(t)
@@ -230,6 +260,31 @@ func TestRecordClient_CopyFiles(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRecordClient_CopyFilePairs(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rcd := &name.Record{ProjectID: "proj1", RecordID: "rec1"}
+
+	mockFileService := &mockFileServiceClient{
+		ctrl: ctrl,
+		copyFilesFunc: func(ctx context.Context, req *connect.Request[openv1alpha1service.CopyFilesRequest]) (*connect.Response[openv1alpha1service.CopyFilesResponse], error) {
+			assert.Equal(t, rcd.String(), req.Msg.Parent)
+			assert.Equal(t, rcd.String(), req.Msg.Destination)
+			require.Len(t, req.Msg.CopyPairs, 1)
+			assert.Equal(t, "raw/a.bag", req.Msg.CopyPairs[0].SrcFile)
+			assert.Equal(t, "bags/a.bag", req.Msg.CopyPairs[0].DstFile)
+			return connect.NewResponse(&openv1alpha1service.CopyFilesResponse{}), nil
+		},
+	}
+
+	client := NewRecordClient(nil, mockFileService, nil, nil)
+
+	err := client.CopyFilePairs(ctx, rcd, rcd, []FileCopyPair{{Src: "raw/a.bag", Dst: "bags/a.bag"}})
+	require.NoError(t, err)
+}
+
 func TestRecordClient_SearchAll(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	ctrl := gomock.NewController(t)
@@ -550,6 +605,42 @@ func TestRecordClient_ListAllEvents(t *testing.T) {
 	})
 }
 
+func TestRecordClient_EventsToMoments(t *testing.T) {
+	ctx := testutil.TestContext(t)
+
+	client := NewRecordClient(nil, nil, nil, nil)
+	moments, err := client.EventsToMoments(ctx, []*openv1alpha1resource.Event{
+		{
+			DisplayName:      "brake",
+			Description:      "hard brake",
+			TriggerTime:      timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Duration:         durationpb.New(1500 * time.Millisecond),
+			CustomizedFields: map[string]string{"source": "lidar"},
+			CustomFieldValues: []*commons.CustomFieldValue{
+				{
+					Property: &commons.Property{Name: "note", Type: &commons.Property_Text{Text: &commons.TextType{}}},
+					Value:    &commons.CustomFieldValue_Text{Text: &commons.TextValue{Value: "check"}},
+				},
+				{
+					Property: &commons.Property{Name: "speed", Type: &commons.Property_Number{Number: &commons.NumberType{}}},
+					Value:    &commons.CustomFieldValue_Number{Number: &commons.NumberValue{Value: 12.5}},
+				},
+			},
+		},
+		{DisplayName: "empty", TriggerTime: timestamppb.Now(), Duration: durationpb.New(time.Second)},
+	})
+	require.NoError(t, err)
+	require.Len(t, moments, 2)
+
+	assert.Equal(t, "brake", moments[0].Name)
+	assert.Equal(t, "hard brake", moments[0].Description)
+	assert.Equal(t, "1.500000000s", moments[0].Duration)
+	assert.Equal(t, map[string]string{"source": "lidar"}, moments[0].Attribute)
+	assert.Equal(t, []map[string]any{{"note": "check"}, {"speed": 12.5}}, moments[0].CustomFieldValues)
+	assert.Equal(t, map[string]string{}, moments[1].Attribute)
+	assert.Empty(t, moments[1].CustomFieldValues)
+}
+
 func TestRecordClient_GenerateRecordThumbnailUploadUrl(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	ctrl := gomock.NewController(t)
@@ -690,6 +781,54 @@ func TestRecordClient_SearchWithAdvancedFilter(t *testing.T) {
 	})
 }
 
+func TestRecordClient_BuildSearchFilter(t *testing.T) {
+	ctx := testutil.TestContext(t)
+	client := &recordClient{}
+	project := &name.Project{ProjectID: "test-project"}
+
+	t.Run("time, creator, device and file restrictions", func(t *testing.T) {
+		filter, err := client.buildSearchFilter(ctx, &SearchRecordsOptions{
+			Project:       project,
+			CreatedAfter:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedSince:  time.Date(2025, 3, 1, 8, 0, 0, 0, time.FixedZone("CST", 8*3600)),
+			CreatorIDs:    []string{"u1", "u2"},
+			DeviceIDs:     []string{"d1"},
+			HasFiles:      []string{"*.mcap"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `isArchived = false`+
+			` AND createTime >= "2025-01-01T00:00:00Z"`+
+			` AND createTime < "2025-02-01T00:00:00Z"`+
+			` AND updateTime >= "2025-03-01T00:00:00Z"`+
+			` AND creator.id in ["u1", "u2"]`+
+			` AND device.id in ["d1"]`+
+			` AND files.path = "*.mcap"`, filter)
+	})
+
+	t.Run("typed custom fields", func(t *testing.T) {
+		filter, err := client.buildSearchFilter(ctx, &SearchRecordsOptions{
+			Project:        project,
+			IncludeArchive: true,
+			CustomFields: []*commons.CustomFieldValue{
+				{Property: &commons.Property{Name: "color"}, Value: &commons.CustomFieldValue_Text{Text: &commons.TextValue{Value: "blue"}}},
+				{Property: &commons.Property{Name: "speed"}, Value: &commons.CustomFieldValue_Number{Number: &commons.NumberValue{Value: 1.5}}},
+				{Property: &commons.Property{Name: "stage"}, Value: &commons.CustomFieldValue_Enums{Enums: &commons.EnumValue{Id: "e1"}}},
+				{Property: &commons.Property{Name: "tags"}, Value: &commons.CustomFieldValue_Enums{Enums: &commons.EnumValue{Ids: []string{"t1", "t2"}}}},
+				{Property: &commons.Property{Name: "at"}, Value: &commons.CustomFieldValue_Time{Time: &commons.TimeValue{Value: timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))}}},
+				{Property: &commons.Property{Name: "owner"}, Value: &commons.CustomFieldValue_User{User: &commons.UserValue{Ids: []string{"u1"}}}},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `customFieldValues.color = "blue"`+
+			` AND customFieldValues.speed = 1.5`+
+			` AND customFieldValues.stage = "e1"`+
+			` AND customFieldValues.tags : "t1" AND customFieldValues.tags : "t2"`+
+			` AND customFieldValues.at = "2025-01-01T00:00:00Z"`+
+			` AND customFieldValues.owner : "u1"`, filter)
+	})
+}
+
 func TestRecordClient_Search_ErrorCodePropagation(t *testing.T) {
 	ctx := testutil.TestContext(t)
 	ctrl := gomock.NewController(t)
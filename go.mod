@@ -13,6 +13,7 @@ require (
 	github.com/dustin/go-humanize v1.0.1
 	github.com/getsentry/sentry-go v0.36.2
 	github.com/golang/mock v1.6.0
+	github.com/klauspost/compress v1.18.0
 	github.com/knadh/koanf/parsers/yaml v0.1.0
 	github.com/knadh/koanf/providers/env v0.1.0
 	github.com/knadh/koanf/providers/file v1.2.0
@@ -23,6 +24,7 @@ require (
 	github.com/minio/sha256-simd v1.0.1
 	github.com/muesli/reflow v0.3.0
 	github.com/oriser/regroup v0.0.0-20240925165441-f6bb0e08289e
+	github.com/pierrec/lz4/v4 v4.1.31
 	github.com/pkg/errors v0.9.1
 	github.com/samber/lo v1.52.0
 	github.com/sanbornm/go-selfupdate v0.0.0-20230714125711-e1c03e3d6ac7
@@ -56,7 +58,6 @@ require (
 	github.com/go-viper/mapstructure/v2 v2.4.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
-	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/klauspost/cpuid/v2 v2.2.11 // indirect
 	github.com/klauspost/crc32 v1.3.0 // indirect
 	github.com/knadh/koanf/maps v0.1.2 // indirect
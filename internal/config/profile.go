@@ -35,12 +35,13 @@ import (
 // Note that if Org is set, then Token is authorized
 // If ProjectName is set, then ProjectSlug is authorized and validated
 type Profile struct {
-	Name                 string `koanf:"name"`
-	EndPoint             string `koanf:"endpoint"`
-	Token                string `koanf:"token"`
-	Org                  string `koanf:"org"`
-	ProjectSlug          string `koanf:"project"`
-	ProjectName          string `koanf:"project-name"`
+	Name                 string         `koanf:"name"`
+	EndPoint             string         `koanf:"endpoint"`
+	Token                string         `koanf:"token"`
+	Org                  string         `koanf:"org"`
+	ProjectSlug          string         `koanf:"project"`
+	ProjectName          string         `koanf:"project-name"`
+	SavedSearches        []*SavedSearch `koanf:"saved-searches,omitempty"`
 	cliOnce              sync.Once
 	orgcli               api.OrganizationInterface
 	projcli              api.ProjectInterface
@@ -57,6 +58,7 @@ type Profile struct {
 	filesystemcli        api.FileSystemInterface
 	rolecli              api.RoleInterface
 	customfieldcli       api.CustomFieldInterface
+	devicecli            api.DeviceInterface
 }
 
 func (p *Profile) StringWithOpts(withStar bool, verbose bool) string {
@@ -267,6 +269,12 @@ func (p *Profile) CustomFieldCli() api.CustomFieldInterface {
 	return p.customfieldcli
 }
 
+// DeviceCli returns device api interface used by profile.
+func (p *Profile) DeviceCli() api.DeviceInterface {
+	p.initCli()
+	return p.devicecli
+}
+
 // initCli initializes the api clients for the profile.
 // This function is ensured to be called only once.
 func (p *Profile) initCli() {
@@ -293,6 +301,7 @@ func (p *Profile) initCli() {
 			containerRegistryServiceClient = openv1alpha1connect.NewContainerRegistryServiceClient(conncli, p.EndPoint, connect.WithGRPC(), interceptorsFactory())
 			storageServiceClient           = openv1alpha1connect.NewFileSystemServiceClient(conncli, p.EndPoint, connect.WithGRPC(), interceptorsFactory())
 			customFieldServiceClient       = openv1alpha1connect.NewCustomFieldServiceClient(conncli, p.EndPoint, connect.WithGRPC(), interceptorsFactory())
+			deviceServiceClient            = openv1alpha1connect.NewDeviceServiceClient(conncli, p.EndPoint, connect.WithGRPC(), interceptorsFactory())
 		)
 
 		p.orgcli = api.NewOrganizationClient(organizationServiceClient)
@@ -310,5 +319,6 @@ func (p *Profile) initCli() {
 		p.filesystemcli = api.NewFileSystemClient(storageServiceClient)
 		p.rolecli = api.NewRoleClient(roleServiceClient)
 		p.customfieldcli = api.NewCustomFieldClient(customFieldServiceClient)
+		p.devicecli = api.NewDeviceClient(deviceServiceClient)
 	})
 }
@@ -175,6 +175,11 @@ func (pm *ProfileManager) CustomFieldCli() api.CustomFieldInterface {
 	return pm.GetCurrentProfile().CustomFieldCli()
 }
 
+// DeviceCli return device client of current profile.
+func (pm *ProfileManager) DeviceCli() api.DeviceInterface {
+	return pm.GetCurrentProfile().DeviceCli()
+}
+
 // GetCurrentProfile return current profile of profile manager.
 func (pm *ProfileManager) GetCurrentProfile() *Profile {
 	for i, profile := range pm.Profiles {
@@ -187,6 +192,16 @@ func (pm *ProfileManager) GetCurrentProfile() *Profile {
 	return nil
 }
 
+// GetProfile return the profile with the given name, or nil if absent.
+func (pm *ProfileManager) GetProfile(name string) *Profile {
+	for i, profile := range pm.Profiles {
+		if profile.Name == name {
+			return pm.Profiles[i]
+		}
+	}
+	return nil
+}
+
 // GetProfiles return all profiles of profile manager.
 func (pm *ProfileManager) GetProfiles() []*Profile {
 	return lo.Map(pm.Profiles, func(p *Profile, _ int) *Profile { return p })
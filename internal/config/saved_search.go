@@ -0,0 +1,106 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SavedSearchPrefix marks a saved search reference in a record selection, e.g. @nightly.
+const SavedSearchPrefix = "@"
+
+var savedSearchNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// SavedSearch is a named record selection stored on a profile. Its fields
+// mirror the record selection flags and keep their raw command line values,
+// so relative times such as --created-after 7d are evaluated on each use.
+type SavedSearch struct {
+	Name           string   `koanf:"name"`
+	Search         string   `koanf:"search,omitempty"`
+	Labels         []string `koanf:"labels,omitempty"`
+	Keywords       []string `koanf:"keywords,omitempty"`
+	IncludeArchive bool     `koanf:"include-archive,omitempty"`
+	CreatedAfter   string   `koanf:"created-after,omitempty"`
+	CreatedBefore  string   `koanf:"created-before,omitempty"`
+	UpdatedSince   string   `koanf:"updated-since,omitempty"`
+	Creators       []string `koanf:"creators,omitempty"`
+	Devices        []string `koanf:"devices,omitempty"`
+	CustomFields   []string `koanf:"custom-fields,omitempty"`
+	HasFiles       []string `koanf:"has-files,omitempty"`
+}
+
+// Validate checks the saved search name.
+func (s *SavedSearch) Validate() error {
+	if !savedSearchNamePattern.MatchString(s.Name) {
+		return errors.Errorf("invalid saved search name %q: use letters, digits, '_', '.' and '-'", s.Name)
+	}
+	return nil
+}
+
+// HasFlagSelection reports whether the saved search selects records by flags
+// other than the search query.
+func (s *SavedSearch) HasFlagSelection() bool {
+	return len(s.Labels) > 0 || len(s.Keywords) > 0 || s.IncludeArchive ||
+		s.CreatedAfter != "" || s.CreatedBefore != "" || s.UpdatedSince != "" ||
+		len(s.Creators) > 0 || len(s.Devices) > 0 || len(s.CustomFields) > 0 || len(s.HasFiles) > 0
+}
+
+// ParseSavedSearchRef returns the saved search name referenced by s, e.g.
+// "nightly" for "@nightly", and whether s is a reference at all.
+func ParseSavedSearchRef(s string) (string, bool) {
+	if !strings.HasPrefix(s, SavedSearchPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(s, SavedSearchPrefix), true
+}
+
+// GetSavedSearch returns the saved search with the given name.
+func (p *Profile) GetSavedSearch(name string) (*SavedSearch, error) {
+	for _, s := range p.SavedSearches {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+	return nil, errors.Errorf("saved search %q not found in profile %s", name, p.Name)
+}
+
+// PutSavedSearch adds a saved search, replacing any existing one with the same name.
+func (p *Profile) PutSavedSearch(search *SavedSearch) error {
+	if err := search.Validate(); err != nil {
+		return err
+	}
+	for i, s := range p.SavedSearches {
+		if s.Name == search.Name {
+			p.SavedSearches[i] = search
+			return nil
+		}
+	}
+	p.SavedSearches = append(p.SavedSearches, search)
+	return nil
+}
+
+// DeleteSavedSearch removes the saved search with the given name.
+func (p *Profile) DeleteSavedSearch(name string) error {
+	for i, s := range p.SavedSearches {
+		if s.Name == name {
+			p.SavedSearches = append(p.SavedSearches[:i], p.SavedSearches[i+1:]...)
+			return nil
+		}
+	}
+	return errors.Errorf("saved search %q not found in profile %s", name, p.Name)
+}
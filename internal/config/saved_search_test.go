@@ -0,0 +1,99 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile_SavedSearches(t *testing.T) {
+	p := &Profile{Name: "dev"}
+
+	require.NoError(t, p.PutSavedSearch(&SavedSearch{Name: "nightly", Labels: []string{"night"}}))
+	require.NoError(t, p.PutSavedSearch(&SavedSearch{Name: "recent", CreatedAfter: "7d"}))
+
+	s, err := p.GetSavedSearch("nightly")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"night"}, s.Labels)
+
+	// Putting an existing name replaces it in place.
+	require.NoError(t, p.PutSavedSearch(&SavedSearch{Name: "nightly", Search: `labels:"night"`}))
+	require.Len(t, p.SavedSearches, 2)
+	assert.Equal(t, "nightly", p.SavedSearches[0].Name)
+	assert.Empty(t, p.SavedSearches[0].Labels)
+
+	require.NoError(t, p.DeleteSavedSearch("nightly"))
+	_, err = p.GetSavedSearch("nightly")
+	assert.ErrorContains(t, err, `saved search "nightly" not found`)
+	assert.Error(t, p.DeleteSavedSearch("nightly"))
+
+	assert.ErrorContains(t, p.PutSavedSearch(&SavedSearch{Name: "@bad name"}), "invalid saved search name")
+}
+
+func TestSavedSearch_HasFlagSelection(t *testing.T) {
+	assert.False(t, (&SavedSearch{Name: "q", Search: `labels:"night"`}).HasFlagSelection())
+	assert.True(t, (&SavedSearch{Name: "l", Labels: []string{"night"}}).HasFlagSelection())
+	assert.True(t, (&SavedSearch{Name: "a", IncludeArchive: true}).HasFlagSelection())
+}
+
+func TestParseSavedSearchRef(t *testing.T) {
+	name, ok := ParseSavedSearchRef("@nightly")
+	assert.True(t, ok)
+	assert.Equal(t, "nightly", name)
+
+	_, ok = ParseSavedSearchRef(`labels:"night"`)
+	assert.False(t, ok)
+}
+
+func TestProvide_Persist_SavedSearches(t *testing.T) {
+	clearCosEnv(t)
+	path := writeTempConfig(t, sampleConfig)
+	cfg := Provide(path)
+
+	pm, err := cfg.GetProfileManager()
+	require.NoError(t, err)
+	require.NoError(t, pm.GetProfile("p2").PutSavedSearch(&SavedSearch{
+		Name:     "nightly",
+		Labels:   []string{"night", "rain"},
+		HasFiles: []string{"*.mcap"},
+	}))
+	require.NoError(t, cfg.Persist(pm))
+
+	reloaded, err := Provide(path).GetProfileManager()
+	require.NoError(t, err)
+	assert.Empty(t, reloaded.GetProfile("p1").SavedSearches)
+	s, err := reloaded.GetProfile("p2").GetSavedSearch("nightly")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"night", "rain"}, s.Labels)
+	assert.Equal(t, []string{"*.mcap"}, s.HasFiles)
+	assert.False(t, s.IncludeArchive)
+
+	// Profiles without saved searches do not grow an empty key on disk.
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(raw), "saved-searches"))
+
+	// Deleting the last saved search persists.
+	require.NoError(t, reloaded.GetProfile("p2").DeleteSavedSearch("nightly"))
+	require.NoError(t, Provide(path).Persist(reloaded))
+	reloaded, err = Provide(path).GetProfileManager()
+	require.NoError(t, err)
+	assert.Empty(t, reloaded.GetProfile("p2").SavedSearches)
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customfield
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	"github.com/coscene-io/cocli/api"
+)
+
+// ToInput converts a stored value back to the key=value form taken by Resolve,
+// so that it can be resolved against the schema of another project or
+// organization where property, enum and user ids differ. Enums are written as
+// display names and users as nicknames looked up through userCli.
+func ToInput(ctx context.Context, userCli api.UserInterface, v *commons.CustomFieldValue) (string, error) {
+	prop := v.GetProperty()
+	var value string
+	switch prop.GetType().(type) {
+	case *commons.Property_Text:
+		value = v.GetText().GetValue()
+	case *commons.Property_Number:
+		value = strconv.FormatFloat(v.GetNumber().GetValue(), 'f', -1, 64)
+	case *commons.Property_Enums:
+		ids := v.GetEnums().GetIds()
+		if !prop.GetEnums().GetMultiple() {
+			ids = []string{v.GetEnums().GetId()}
+		}
+		names := make([]string, 0, len(ids))
+		for _, id := range ids {
+			displayName, ok := prop.GetEnums().GetValues()[id]
+			if !ok {
+				return "", fmt.Errorf("custom field %q: unknown enum id %q", prop.GetName(), id)
+			}
+			names = append(names, displayName)
+		}
+		value = strings.Join(names, multiValueSep)
+	case *commons.Property_Time:
+		value = v.GetTime().GetValue().AsTime().Format(time.RFC3339)
+	case *commons.Property_User:
+		nicknames := make([]string, 0, len(v.GetUser().GetIds()))
+		for _, id := range v.GetUser().GetIds() {
+			user, err := userCli.GetUser(ctx, "users/"+id)
+			if err != nil {
+				return "", fmt.Errorf("custom field %q: failed to get user %s: %w", prop.GetName(), id, err)
+			}
+			if user.GetNickname() == "" {
+				return "", fmt.Errorf("custom field %q: user %s has no nickname", prop.GetName(), id)
+			}
+			nicknames = append(nicknames, user.GetNickname())
+		}
+		value = strings.Join(nicknames, multiValueSep)
+	default:
+		return "", fmt.Errorf("custom field %q: unknown type", prop.GetName())
+	}
+	return prop.GetName() + "=" + value, nil
+}
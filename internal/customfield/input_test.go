@@ -0,0 +1,64 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customfield
+
+import (
+	"context"
+	"testing"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToInputRoundTrip(t *testing.T) {
+	users := &mockUserClient{
+		findByNickname: map[string][]*openv1alpha1resource.User{
+			"张三": {{Name: "users/abc-123"}},
+			"李四": {{Name: "users/def-456"}},
+		},
+		users: map[string]*openv1alpha1resource.User{
+			"users/abc-123": {Name: "users/abc-123", Nickname: "张三"},
+			"users/def-456": {Name: "users/def-456", Nickname: "李四"},
+		},
+	}
+	inputs := []string{
+		"color=blue",
+		"count=42.5",
+		"priority=high",
+		"tags=bug;docs",
+		"deadline=2025-01-01T10:30:00Z",
+		"reviewers=张三;李四",
+	}
+
+	values, err := NewResolver(testSchema(), users).Resolve(context.Background(), inputs)
+	require.NoError(t, err)
+
+	for i, v := range values {
+		input, err := ToInput(context.Background(), users, v)
+		require.NoError(t, err)
+		assert.Equal(t, inputs[i], input)
+	}
+}
+
+func TestToInputUnknownEnumID(t *testing.T) {
+	values, err := NewResolver(testSchema(), newMockUserClient(nil)).Resolve(context.Background(), []string{"priority=high"})
+	require.NoError(t, err)
+	values[0].GetEnums().Id = "missing"
+
+	_, err = ToInput(context.Background(), newMockUserClient(nil), values[0])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown enum id "missing"`)
+}
@@ -214,7 +214,12 @@ func (r *Resolver) resolveUser(ctx context.Context, prop *commons.Property, valu
 }
 
 func (r *Resolver) resolveOneUser(ctx context.Context, nickname string) (string, error) {
-	users, err := r.userCli.FindUsersByNickname(ctx, nickname)
+	return ResolveUserID(ctx, r.userCli, nickname)
+}
+
+// ResolveUserID resolves a nickname to the id of the single user carrying it.
+func ResolveUserID(ctx context.Context, userCli api.UserInterface, nickname string) (string, error) {
+	users, err := userCli.FindUsersByNickname(ctx, nickname)
 	if err != nil {
 		return "", fmt.Errorf("failed to find user %q: %w", nickname, err)
 	}
@@ -16,6 +16,8 @@ package customfield
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -31,6 +33,7 @@ import (
 type mockUserClient struct {
 	findByNickname map[string][]*openv1alpha1resource.User
 	findErr        error
+	users          map[string]*openv1alpha1resource.User
 }
 
 func (m *mockUserClient) BatchGetUsers(_ context.Context, _ mapset.Set[name.User]) (map[string]*openv1alpha1resource.User, error) {
@@ -41,8 +44,8 @@ func (m *mockUserClient) ListUsers(_ context.Context, _ *api.ListUsersOptions) (
 	return &api.ListUsersResult{}, nil
 }
 
-func (m *mockUserClient) GetUser(_ context.Context, _ string) (*openv1alpha1resource.User, error) {
-	return nil, nil
+func (m *mockUserClient) GetUser(_ context.Context, userName string) (*openv1alpha1resource.User, error) {
+	return m.users[userName], nil
 }
 
 func (m *mockUserClient) FindUsersByNickname(_ context.Context, nickname string) ([]*openv1alpha1resource.User, error) {
@@ -260,3 +263,76 @@ func TestResolveValueContainsEquals(t *testing.T) {
 	require.Len(t, result, 1)
 	assert.Equal(t, "a=b=c", result[0].GetText().GetValue())
 }
+
+func TestLoadValuesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"color": "blue", "count": 3, "tags": ["bug", "docs"]}`), 0o644))
+
+	values, err := LoadValuesFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "blue", values["color"])
+	assert.Equal(t, float64(3), values["count"])
+	assert.Equal(t, []any{"bug", "docs"}, values["tags"])
+}
+
+func TestLoadValuesFileNotObject(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.json")
+	require.NoError(t, os.WriteFile(path, []byte(`["color=blue"]`), 0o644))
+
+	_, err := LoadValuesFile(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected a JSON object")
+}
+
+func TestValuesToInputs(t *testing.T) {
+	r := NewResolver(testSchema(), newMockUserClient(nil))
+	inputs, err := r.ValuesToInputs(map[string]any{
+		"color":     "blue",
+		"count":     42.5,
+		"tags":      []any{"bug", "docs"},
+		"deadline":  "2025-01-01",
+		"reviewers": []any{"张三", "李四"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"color=blue",
+		"count=42.5",
+		"deadline=2025-01-01",
+		"reviewers=张三;李四",
+		"tags=bug;docs",
+	}, inputs)
+
+	result, err := NewResolver(testSchema(), newMockUserClient(map[string][]*openv1alpha1resource.User{
+		"张三": {{Name: "users/abc-123"}},
+		"李四": {{Name: "users/def-456"}},
+	})).Resolve(context.Background(), inputs)
+	require.NoError(t, err)
+	require.Len(t, result, 5)
+}
+
+func TestValuesToInputsReportsAllErrors(t *testing.T) {
+	r := NewResolver(testSchema(), newMockUserClient(nil))
+	_, err := r.ValuesToInputs(map[string]any{
+		"unknown":  "x",
+		"count":    "abc",
+		"priority": []any{"high", "low"},
+		"tags":     []any{"bug", "nope"},
+		"deadline": "tomorrow",
+		"assignee": true,
+	})
+	require.Error(t, err)
+	msg := err.Error()
+	assert.Contains(t, msg, `unknown custom field "unknown"`)
+	assert.Contains(t, msg, `custom field "count": invalid number`)
+	assert.Contains(t, msg, `custom field "priority": expected a single value`)
+	assert.Contains(t, msg, `unknown enum value "nope"`)
+	assert.Contains(t, msg, `custom field "deadline": invalid time`)
+	assert.Contains(t, msg, `custom field "assignee": unsupported value`)
+}
+
+func TestValuesToInputsRejectsSeparatorInList(t *testing.T) {
+	r := NewResolver(testSchema(), newMockUserClient(nil))
+	_, err := r.ValuesToInputs(map[string]any{"tags": []any{"bug;docs"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must not contain")
+}
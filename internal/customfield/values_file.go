@@ -0,0 +1,121 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customfield
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	"github.com/samber/lo"
+)
+
+// LoadValuesFile reads a JSON object of custom field values keyed by field
+// name, e.g. {"color": "blue", "count": 3, "tags": ["a", "b"]}.
+func LoadValuesFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]any
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("%s: expected a JSON object of custom field values: %w", path, err)
+	}
+	return values, nil
+}
+
+// ValuesToInputs validates values loaded by LoadValuesFile against the schema
+// and converts them to the key=value inputs taken by Resolve. Every problem is
+// reported, not only the first one. User nicknames are only checked by Resolve.
+func (r *Resolver) ValuesToInputs(values map[string]any) ([]string, error) {
+	keys := lo.Keys(values)
+	slices.Sort(keys)
+
+	var (
+		inputs []string
+		errs   []error
+	)
+	for _, key := range keys {
+		prop := r.findProperty(key)
+		if prop == nil {
+			available := lo.Map(r.schema.Properties, func(p *commons.Property, _ int) string {
+				return p.Name
+			})
+			errs = append(errs, fmt.Errorf("unknown custom field %q, available fields: %s", key, strings.Join(available, ", ")))
+			continue
+		}
+		value, err := fileValueString(prop, values[key])
+		if err == nil {
+			err = checkValue(prop, value)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("custom field %q: %w", key, err))
+			continue
+		}
+		inputs = append(inputs, key+"="+value)
+	}
+	return inputs, errors.Join(errs...)
+}
+
+// fileValueString converts a JSON value to the textual form accepted by
+// resolveValue. Lists are only accepted by multi-value fields.
+func fileValueString(prop *commons.Property, v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case float64:
+		if prop.GetNumber() == nil && prop.GetText() == nil {
+			return "", fmt.Errorf("expected a string, got number %v", v)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []any:
+		if !prop.GetEnums().GetMultiple() && !prop.GetUser().GetMultiple() {
+			return "", fmt.Errorf("expected a single value, got a list")
+		}
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return "", fmt.Errorf("expected a list of strings, got %v", item)
+			}
+			if strings.Contains(s, multiValueSep) {
+				return "", fmt.Errorf("list item %q must not contain %q", s, multiValueSep)
+			}
+			items = append(items, s)
+		}
+		return strings.Join(items, multiValueSep), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v, expected a string, number or list of strings", v)
+	}
+}
+
+// checkValue validates the value of every type that needs no RPC to resolve.
+func checkValue(prop *commons.Property, value string) error {
+	var err error
+	switch prop.GetType().(type) {
+	case *commons.Property_Number:
+		_, err = resolveNumber(prop, value)
+	case *commons.Property_Enums:
+		_, err = resolveEnum(prop, value)
+	case *commons.Property_Time:
+		_, err = resolveTime(prop, value)
+	}
+	return err
+}
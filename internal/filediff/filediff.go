@@ -0,0 +1,66 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filediff compares two sets of files by path, size and sha256.
+package filediff
+
+import (
+	"sort"
+)
+
+// Status describes how a path differs between two file sets.
+type Status string
+
+const (
+	OnlyInA Status = "only-in-a"
+	OnlyInB Status = "only-in-b"
+	Differ  Status = "differ"
+)
+
+// Digest identifies the content of a file.
+type Digest struct {
+	Sha256 string
+	Size   int64
+}
+
+// Entry is a path whose presence or content differs between A and B.
+type Entry struct {
+	Path   string
+	Status Status
+	A      *Digest // nil if the path is only in B
+	B      *Digest // nil if the path is only in A
+}
+
+// Compare returns the differing entries of two file sets keyed by relative
+// path, sorted by path. Identical files are omitted.
+func Compare(a, b map[string]Digest) []*Entry {
+	var ret []*Entry
+	for path, da := range a {
+		db, ok := b[path]
+		switch {
+		case !ok:
+			ret = append(ret, &Entry{Path: path, Status: OnlyInA, A: &da})
+		case da != db:
+			ret = append(ret, &Entry{Path: path, Status: Differ, A: &da, B: &db})
+		}
+	}
+	for path, db := range b {
+		if _, ok := a[path]; !ok {
+			ret = append(ret, &Entry{Path: path, Status: OnlyInB, B: &db})
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Path < ret[j].Path })
+	return ret
+}
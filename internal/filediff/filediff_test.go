@@ -0,0 +1,50 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filediff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	a := map[string]Digest{
+		"same.bag":    {Sha256: "s1", Size: 10},
+		"changed.bag": {Sha256: "c1", Size: 20},
+		"resized.bag": {Sha256: "r1", Size: 30},
+		"only-a.txt":  {Sha256: "a1", Size: 1},
+	}
+	b := map[string]Digest{
+		"same.bag":        {Sha256: "s1", Size: 10},
+		"changed.bag":     {Sha256: "c2", Size: 20},
+		"resized.bag":     {Sha256: "r1", Size: 31},
+		"dir/only-b.json": {Sha256: "b1", Size: 2},
+	}
+
+	got := Compare(a, b)
+	assert.Equal(t, []*Entry{
+		{Path: "changed.bag", Status: Differ, A: &Digest{"c1", 20}, B: &Digest{"c2", 20}},
+		{Path: "dir/only-b.json", Status: OnlyInB, B: &Digest{"b1", 2}},
+		{Path: "only-a.txt", Status: OnlyInA, A: &Digest{"a1", 1}},
+		{Path: "resized.bag", Status: Differ, A: &Digest{"r1", 30}, B: &Digest{"r1", 31}},
+	}, got)
+}
+
+func TestCompare_Identical(t *testing.T) {
+	a := map[string]Digest{"x": {Sha256: "1", Size: 1}}
+	assert.Empty(t, Compare(a, a))
+	assert.Empty(t, Compare(nil, nil))
+}
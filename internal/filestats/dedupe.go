@@ -0,0 +1,71 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestats
+
+import (
+	"sort"
+)
+
+// DuplicateSet is a piece of content stored more than once.
+type DuplicateSet struct {
+	Sha256 string
+	Size   int64
+	// Copies are ordered by owner, then path.
+	Copies []File
+}
+
+// Wasted returns the bytes that would be freed by keeping a single copy.
+func (d *DuplicateSet) Wasted() int64 {
+	return d.Size * int64(len(d.Copies)-1)
+}
+
+// Duplicates groups files by Sha256 and returns the content stored more than
+// once, ordered by wasted bytes descending, then hash. Files without a hash
+// and empty files are ignored.
+func Duplicates(files []File) []*DuplicateSet {
+	byHash := make(map[string]*DuplicateSet)
+	for _, f := range files {
+		if f.Sha256 == "" || f.Size == 0 {
+			continue
+		}
+		d, ok := byHash[f.Sha256]
+		if !ok {
+			d = &DuplicateSet{Sha256: f.Sha256, Size: f.Size}
+			byHash[f.Sha256] = d
+		}
+		d.Copies = append(d.Copies, f)
+	}
+
+	ret := make([]*DuplicateSet, 0)
+	for _, d := range byHash {
+		if len(d.Copies) < 2 {
+			continue
+		}
+		sort.Slice(d.Copies, func(i, j int) bool {
+			if d.Copies[i].Owner != d.Copies[j].Owner {
+				return d.Copies[i].Owner < d.Copies[j].Owner
+			}
+			return d.Copies[i].Path < d.Copies[j].Path
+		})
+		ret = append(ret, d)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Wasted() != ret[j].Wasted() {
+			return ret[i].Wasted() > ret[j].Wasted()
+		}
+		return ret[i].Sha256 < ret[j].Sha256
+	})
+	return ret
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicates(t *testing.T) {
+	files := []File{
+		{Owner: "rec-2", Path: "a.bag", Size: 100, Sha256: "aaa"},
+		{Owner: "rec-1", Path: "raw/a.bag", Size: 100, Sha256: "aaa"},
+		{Owner: "rec-3", Path: "a.bag", Size: 100, Sha256: "aaa"},
+		{Owner: "rec-1", Path: "big.mcap", Size: 500, Sha256: "bbb"},
+		{Owner: "rec-2", Path: "big.mcap", Size: 500, Sha256: "bbb"},
+		{Owner: "rec-1", Path: "unique.json", Size: 10, Sha256: "ccc"},
+		{Owner: "rec-1", Path: "empty", Size: 0, Sha256: "e3b0"},
+		{Owner: "rec-2", Path: "empty", Size: 0, Sha256: "e3b0"},
+		{Owner: "rec-1", Path: "nohash", Size: 10},
+		{Owner: "rec-2", Path: "nohash", Size: 10},
+	}
+
+	dups := Duplicates(files)
+	require.Len(t, dups, 2)
+
+	assert.Equal(t, "bbb", dups[0].Sha256)
+	assert.Equal(t, int64(500), dups[0].Wasted())
+
+	assert.Equal(t, "aaa", dups[1].Sha256)
+	assert.Equal(t, int64(200), dups[1].Wasted())
+	assert.Equal(t, []string{"rec-1", "rec-2", "rec-3"}, []string{dups[1].Copies[0].Owner, dups[1].Copies[1].Owner, dups[1].Copies[2].Owner})
+}
+
+func TestDuplicatesNone(t *testing.T) {
+	assert.Empty(t, Duplicates([]File{{Owner: "rec-1", Path: "a", Size: 1, Sha256: "x"}}))
+	assert.Empty(t, Duplicates(nil))
+}
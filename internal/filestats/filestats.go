@@ -0,0 +1,124 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filestats aggregates file counts and sizes for storage reports.
+package filestats
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+// NoneKey is the group key of files without an extension, or of records
+// without a label.
+const NoneKey = "(none)"
+
+// RootDir is the directory key of files at the top level.
+const RootDir = "/"
+
+// File is a file to aggregate.
+type File struct {
+	// Owner optionally identifies where the file lives, e.g. a record id.
+	Owner string
+	// Path is the slash-separated path relative to the owner.
+	Path string
+	Size int64
+	// Sha256 is the content hash, only needed by Duplicates.
+	Sha256 string
+}
+
+// Group is the aggregate of the files (and records) sharing a key.
+type Group struct {
+	Key     string
+	Records int
+	Files   int64
+	Bytes   int64
+}
+
+// Stats is the aggregate of a set of files.
+type Stats struct {
+	FileCount   int64
+	TotalBytes  int64
+	Largest     []File
+	ByExtension []*Group
+	ByDirectory []*Group
+}
+
+// Compute aggregates files, keeping the topN largest files.
+func Compute(files []File, topN int) *Stats {
+	stats := &Stats{}
+	byExt := make(map[string]*Group)
+	byDir := make(map[string]*Group)
+	for _, f := range files {
+		stats.FileCount++
+		stats.TotalBytes += f.Size
+		add(byExt, Extension(f.Path), f.Size)
+		add(byDir, TopDir(f.Path), f.Size)
+	}
+
+	largest := make([]File, len(files))
+	copy(largest, files)
+	sort.SliceStable(largest, func(i, j int) bool { return largest[i].Size > largest[j].Size })
+	if len(largest) > topN {
+		largest = largest[:topN]
+	}
+	stats.Largest = largest
+	stats.ByExtension = SortGroups(byExt)
+	stats.ByDirectory = SortGroups(byDir)
+	return stats
+}
+
+// Extension returns the lower-cased extension of p, or NoneKey.
+func Extension(p string) string {
+	ext := strings.ToLower(path.Ext(p))
+	if ext == "" {
+		return NoneKey
+	}
+	return ext
+}
+
+// TopDir returns the first path element of p, or RootDir for top-level files.
+func TopDir(p string) string {
+	p = strings.TrimPrefix(p, "/")
+	if idx := strings.Index(p, "/"); idx >= 0 {
+		return p[:idx] + "/"
+	}
+	return RootDir
+}
+
+// SortGroups returns the groups ordered by bytes descending, then key.
+func SortGroups(groups map[string]*Group) []*Group {
+	ret := make([]*Group, 0, len(groups))
+	for _, g := range groups {
+		ret = append(ret, g)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Bytes != ret[j].Bytes {
+			return ret[i].Bytes > ret[j].Bytes
+		}
+		return ret[i].Key < ret[j].Key
+	})
+	return ret
+}
+
+func add(groups map[string]*Group, key string, size int64) {
+	g, ok := groups[key]
+	if !ok {
+		g = &Group{Key: key}
+		groups[key] = g
+	}
+	g.Files++
+	g.Bytes += size
+}
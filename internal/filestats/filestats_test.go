@@ -0,0 +1,69 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompute(t *testing.T) {
+	files := []File{
+		{Path: "raw/a.bag", Size: 100},
+		{Path: "raw/b.BAG", Size: 300},
+		{Path: "meta/info.json", Size: 10},
+		{Path: "README", Size: 5},
+		{Path: "c.mcap", Size: 200},
+	}
+
+	stats := Compute(files, 2)
+	assert.Equal(t, int64(5), stats.FileCount)
+	assert.Equal(t, int64(615), stats.TotalBytes)
+	assert.Equal(t, []File{{Path: "raw/b.BAG", Size: 300}, {Path: "c.mcap", Size: 200}}, stats.Largest)
+
+	assert.Equal(t, []*Group{
+		{Key: ".bag", Files: 2, Bytes: 400},
+		{Key: ".mcap", Files: 1, Bytes: 200},
+		{Key: ".json", Files: 1, Bytes: 10},
+		{Key: NoneKey, Files: 1, Bytes: 5},
+	}, stats.ByExtension)
+	assert.Equal(t, []*Group{
+		{Key: "raw/", Files: 2, Bytes: 400},
+		{Key: RootDir, Files: 2, Bytes: 205},
+		{Key: "meta/", Files: 1, Bytes: 10},
+	}, stats.ByDirectory)
+
+	// The input order is left untouched.
+	assert.Equal(t, "raw/a.bag", files[0].Path)
+}
+
+func TestCompute_Empty(t *testing.T) {
+	stats := Compute(nil, 10)
+	require.NotNil(t, stats)
+	assert.Zero(t, stats.FileCount)
+	assert.Empty(t, stats.Largest)
+	assert.Empty(t, stats.ByExtension)
+}
+
+func TestSortGroups_TieBreaksByKey(t *testing.T) {
+	groups := SortGroups(map[string]*Group{
+		"b": {Key: "b", Bytes: 1},
+		"a": {Key: "a", Bytes: 1},
+		"c": {Key: "c", Bytes: 2},
+	})
+	assert.Equal(t, []string{"c", "a", "b"}, []string{groups[0].Key, groups[1].Key, groups[2].Key})
+}
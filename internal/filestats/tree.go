@@ -0,0 +1,126 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestats
+
+import (
+	"sort"
+	"strings"
+)
+
+// TreeNode is a directory or a file of a file tree. Directories aggregate the
+// files and bytes below them.
+type TreeNode struct {
+	Name  string
+	Dir   bool
+	Files int64
+	Bytes int64
+	// Children are ordered directories first, then by name.
+	Children []*TreeNode
+}
+
+// TreeLine is a node rendered as a line of a tree, e.g. "│   ├── " + "raw/".
+type TreeLine struct {
+	Prefix string
+	Node   *TreeNode
+	// Path is the full path of the node, e.g. "raw/sub/" or "raw/sub/c.bag".
+	Path string
+}
+
+// BuildTree builds the tree of files, rooted at a directory named RootDir.
+func BuildTree(files []File) *TreeNode {
+	root := &TreeNode{Name: RootDir, Dir: true}
+	for _, f := range files {
+		parts := strings.Split(strings.Trim(f.Path, "/"), "/")
+		node := root
+		node.Files++
+		node.Bytes += f.Size
+		for i, part := range parts {
+			isDir := i < len(parts)-1
+			node = node.child(part, isDir)
+			node.Bytes += f.Size
+			if isDir {
+				node.Files++
+			}
+		}
+	}
+	root.sort()
+	return root
+}
+
+func (n *TreeNode) child(name string, dir bool) *TreeNode {
+	if dir {
+		name += "/"
+	}
+	for _, c := range n.Children {
+		if c.Name == name {
+			return c
+		}
+	}
+	c := &TreeNode{Name: name, Dir: dir}
+	n.Children = append(n.Children, c)
+	return c
+}
+
+func (n *TreeNode) sort() {
+	sort.Slice(n.Children, func(i, j int) bool {
+		a, b := n.Children[i], n.Children[j]
+		if a.Dir != b.Dir {
+			return a.Dir
+		}
+		return a.Name < b.Name
+	})
+	for _, c := range n.Children {
+		c.sort()
+	}
+}
+
+// Prune returns a copy of the tree without the nodes more than depth levels
+// below the root; their directories still count them. Zero keeps every level.
+func (n *TreeNode) Prune(depth int) *TreeNode {
+	if depth <= 0 {
+		return n
+	}
+	return n.prune(depth)
+}
+
+func (n *TreeNode) prune(levels int) *TreeNode {
+	ret := *n
+	ret.Children = nil
+	if levels == 0 {
+		return &ret
+	}
+	for _, c := range n.Children {
+		ret.Children = append(ret.Children, c.prune(levels-1))
+	}
+	return &ret
+}
+
+// Lines renders the tree line by line, starting with the root.
+func (n *TreeNode) Lines() []TreeLine {
+	ret := []TreeLine{{Node: n, Path: n.Name}}
+	return n.appendLines(ret, "", "")
+}
+
+func (n *TreeNode) appendLines(lines []TreeLine, indent string, dir string) []TreeLine {
+	for i, c := range n.Children {
+		branch, next := "├── ", "│   "
+		if i == len(n.Children)-1 {
+			branch, next = "└── ", "    "
+		}
+		lines = append(lines, TreeLine{Prefix: indent + branch, Node: c, Path: dir + c.Name})
+		lines = c.appendLines(lines, indent+next, dir+c.Name)
+	}
+	return lines
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestats
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func treeFiles() []File {
+	return []File{
+		{Path: "raw/b.bag", Size: 300},
+		{Path: "raw/a.bag", Size: 100},
+		{Path: "raw/sub/c.bag", Size: 50},
+		{Path: "README", Size: 5},
+		{Path: "meta/info.json", Size: 10},
+	}
+}
+
+func TestBuildTree(t *testing.T) {
+	root := BuildTree(treeFiles())
+	assert.Equal(t, RootDir, root.Name)
+	assert.Equal(t, int64(5), root.Files)
+	assert.Equal(t, int64(465), root.Bytes)
+
+	names := make([]string, 0, len(root.Children))
+	for _, c := range root.Children {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"meta/", "raw/", "README"}, names)
+
+	raw := root.Children[1]
+	assert.True(t, raw.Dir)
+	assert.Equal(t, int64(3), raw.Files)
+	assert.Equal(t, int64(450), raw.Bytes)
+	require.Len(t, raw.Children, 3)
+	assert.Equal(t, "sub/", raw.Children[0].Name)
+	assert.Equal(t, "a.bag", raw.Children[1].Name)
+	assert.False(t, raw.Children[1].Dir)
+}
+
+func TestTreeLines(t *testing.T) {
+	var rendered []string
+	for _, l := range BuildTree(treeFiles()).Lines() {
+		rendered = append(rendered, l.Prefix+l.Node.Name)
+	}
+	assert.Equal(t, []string{
+		"/",
+		"├── meta/",
+		"│   └── info.json",
+		"├── raw/",
+		"│   ├── sub/",
+		"│   │   └── c.bag",
+		"│   ├── a.bag",
+		"│   └── b.bag",
+		"└── README",
+	}, rendered)
+}
+
+func TestTreeLines_Path(t *testing.T) {
+	paths := lo.Map(BuildTree(treeFiles()).Lines(), func(l TreeLine, _ int) string { return l.Path })
+	assert.Equal(t, []string{
+		"/",
+		"meta/",
+		"meta/info.json",
+		"raw/",
+		"raw/sub/",
+		"raw/sub/c.bag",
+		"raw/a.bag",
+		"raw/b.bag",
+		"README",
+	}, paths)
+}
+
+func TestTreePrune(t *testing.T) {
+	root := BuildTree(treeFiles())
+	pruned := root.Prune(1)
+	require.Len(t, pruned.Children, 3)
+	for _, c := range pruned.Children {
+		assert.Empty(t, c.Children)
+	}
+	assert.Equal(t, int64(3), pruned.Children[1].Files, "pruned directories still count their files")
+	assert.Len(t, root.Children[1].Children, 3, "the original tree is left intact")
+
+	assert.Equal(t, root, root.Prune(0))
+}
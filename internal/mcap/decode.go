@@ -0,0 +1,121 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcap
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Decoder decodes messages into generic values, keyed by the field names of
+// their schema. It supports the json, protobuf, ros1 and cdr (ROS 2) message
+// encodings. Schemas are parsed once per channel.
+type Decoder struct {
+	decoders map[*Channel]func([]byte) (map[string]any, error)
+}
+
+func NewDecoder() *Decoder {
+	return &Decoder{decoders: map[*Channel]func([]byte) (map[string]any, error){}}
+}
+
+// Decode decodes the data of m.
+func (d *Decoder) Decode(m *Message) (map[string]any, error) {
+	decode, ok := d.decoders[m.Channel]
+	if !ok {
+		var err error
+		if decode, err = newChannelDecoder(m.Channel); err != nil {
+			return nil, fmt.Errorf("topic %s: %w", m.Channel.Topic, err)
+		}
+		d.decoders[m.Channel] = decode
+	}
+	return decode(m.Data)
+}
+
+func newChannelDecoder(c *Channel) (func([]byte) (map[string]any, error), error) {
+	schemaEncoding := ""
+	if c.Schema != nil {
+		schemaEncoding = c.Schema.Encoding
+	}
+
+	switch {
+	case c.MessageEncoding == "json":
+		return decodeJSON, nil
+	case c.MessageEncoding == "protobuf" && schemaEncoding == "protobuf":
+		return newProtobufDecoder(c.Schema)
+	case c.MessageEncoding == "ros1" && schemaEncoding == "ros1msg":
+		msg, err := parseRosSchema(c.Schema.Name, string(c.Schema.Data), false)
+		if err != nil {
+			return nil, err
+		}
+		return msg.decodeRos1, nil
+	case c.MessageEncoding == "cdr" && schemaEncoding == "ros2msg":
+		msg, err := parseRosSchema(c.Schema.Name, string(c.Schema.Data), true)
+		if err != nil {
+			return nil, err
+		}
+		return msg.decodeCdr, nil
+	default:
+		return nil, fmt.Errorf("unsupported message encoding %q with schema encoding %q", c.MessageEncoding, schemaEncoding)
+	}
+}
+
+func decodeJSON(data []byte) (map[string]any, error) {
+	var v map[string]any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("invalid json message: %w", err)
+	}
+	return v, nil
+}
+
+// newProtobufDecoder decodes messages whose schema is a binary
+// FileDescriptorSet. Fields keep the names of the .proto file.
+func newProtobufDecoder(schema *Schema) (func([]byte) (map[string]any, error), error) {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(schema.Data, fds); err != nil {
+		return nil, fmt.Errorf("invalid protobuf schema %s: %w", schema.Name, err)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protobuf schema %s: %w", schema.Name, err)
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(schema.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid protobuf schema %s: %w", schema.Name, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("invalid protobuf schema %s: not a message", schema.Name)
+	}
+
+	marshal := protojson.MarshalOptions{UseProtoNames: true}
+	return func(data []byte) (map[string]any, error) {
+		msg := dynamicpb.NewMessage(msgDesc)
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return nil, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+		raw, err := marshal.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		return decodeJSON(raw)
+	}, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const rosSeparator = "\n================================================================================\n"
+
+func le(values ...any) []byte {
+	var b bytes.Buffer
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			_ = binary.Write(&b, binary.LittleEndian, uint32(len(s)))
+			b.WriteString(s)
+			continue
+		}
+		_ = binary.Write(&b, binary.LittleEndian, v)
+	}
+	return b.Bytes()
+}
+
+func decodeOne(t *testing.T, c *Channel, data []byte) (map[string]any, error) {
+	t.Helper()
+	return NewDecoder().Decode(&Message{Channel: c, Data: data})
+}
+
+func TestDecoder(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		v, err := decodeOne(t, &Channel{Topic: "/events", MessageEncoding: "json"}, []byte(`{"name":"brake","stamp":{"sec":1}}`))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "brake", "stamp": map[string]any{"sec": float64(1)}}, v)
+
+		_, err = decodeOne(t, &Channel{Topic: "/events", MessageEncoding: "json"}, []byte(`[1]`))
+		assert.ErrorContains(t, err, "invalid json message")
+	})
+
+	t.Run("protobuf", func(t *testing.T) {
+		fds := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+		}}
+		schemaData, err := proto.Marshal(fds)
+		require.NoError(t, err)
+		data, err := proto.Marshal(&descriptorpb.FieldDescriptorProto{Name: proto.String("brake"), JsonName: proto.String("b")})
+		require.NoError(t, err)
+
+		c := &Channel{
+			Topic:           "/events",
+			MessageEncoding: "protobuf",
+			Schema:          &Schema{Name: "google.protobuf.FieldDescriptorProto", Encoding: "protobuf", Data: schemaData},
+		}
+		v, err := decodeOne(t, c, data)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "brake", "json_name": "b"}, v)
+	})
+
+	t.Run("ros1", func(t *testing.T) {
+		schema := "Header header\n" +
+			"string name # what happened\n" +
+			"float32[] values\n" +
+			"time stamp\n" +
+			"uint8[2] flags\n" +
+			"int32 LEVEL=1 # constants are not serialized\n" +
+			"string NOTE=a#b\n" +
+			rosSeparator +
+			"MSG: std_msgs/Header\n" +
+			"uint32 seq\n" +
+			"time stamp\n" +
+			"string frame_id\n"
+		data := le(uint32(7), uint32(100), uint32(5), "map",
+			"brake",
+			uint32(2), float32(1.5), float32(-2),
+			uint32(200), uint32(0),
+			[2]uint8{1, 2})
+
+		c := &Channel{Topic: "/events", MessageEncoding: "ros1", Schema: &Schema{Name: "my_msgs/Event", Encoding: "ros1msg", Data: []byte(schema)}}
+		v, err := decodeOne(t, c, data)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"header": map[string]any{"seq": uint64(7), "stamp": map[string]any{"sec": uint64(100), "nsec": uint64(5)}, "frame_id": "map"},
+			"name":   "brake",
+			"values": []any{1.5, float64(-2)},
+			"stamp":  map[string]any{"sec": uint64(200), "nsec": uint64(0)},
+			"flags":  []byte{1, 2},
+		}, v)
+
+		_, err = decodeOne(t, c, data[:len(data)-1])
+		assert.ErrorContains(t, err, "flags: truncated message")
+	})
+
+	t.Run("cdr", func(t *testing.T) {
+		schema := "builtin_interfaces/Time stamp\n" +
+			"string name\n" +
+			"int8 level 3 # default value\n" +
+			"float64 score\n" +
+			"int32[] ids\n" +
+			"uint16[<=3] small\n" +
+			"string<=8 tag\n" +
+			rosSeparator +
+			"MSG: builtin_interfaces/Time\n" +
+			"int32 sec\n" +
+			"uint32 nanosec\n"
+
+		var data []byte
+		data = append(data, 0, 1, 0, 0)                           // little-endian CDR
+		data = append(data, le(int32(100), uint32(5))...)         // 0: stamp
+		data = append(data, le(uint32(6))...)                     // 8: name length with NUL
+		data = append(data, "brake\x00"...)                       // 12
+		data = append(data, 0xFE)                                 // 18: level
+		data = append(data, 0, 0, 0, 0, 0)                        // 19: pad to 24
+		data = append(data, le(math.Float64bits(0.5))...)         // 24: score
+		data = append(data, le(uint32(2), int32(3), int32(4))...) // 32: ids
+		data = append(data, le(uint32(1), uint16(9))...)          // 44: small
+		data = append(data, 0, 0)                                 // 50: pad to 52
+		data = append(data, le(uint32(2))...)                     // 52: tag
+		data = append(data, "x\x00"...)
+
+		c := &Channel{Topic: "/events", MessageEncoding: "cdr", Schema: &Schema{Name: "my_msgs/msg/Event", Encoding: "ros2msg", Data: []byte(schema)}}
+		v, err := decodeOne(t, c, data)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"stamp": map[string]any{"sec": int64(100), "nanosec": uint64(5)},
+			"name":  "brake",
+			"level": int64(-2),
+			"score": 0.5,
+			"ids":   []any{int64(3), int64(4)},
+			"small": []any{uint64(9)},
+			"tag":   "x",
+		}, v)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		c := &Channel{Topic: "/events", MessageEncoding: "ros1", Schema: &Schema{Name: "my_msgs/Event", Encoding: "ros1msg", Data: []byte("Missing m\n")}}
+		_, err := decodeOne(t, c, nil)
+		assert.ErrorContains(t, err, "my_msgs/Event.m has unknown type my_msgs/Missing")
+	})
+
+	t.Run("unsupported encoding", func(t *testing.T) {
+		_, err := decodeOne(t, &Channel{Topic: "/events", MessageEncoding: "flatbuffer"}, nil)
+		assert.ErrorContains(t, err, `topic /events: unsupported message encoding "flatbuffer"`)
+	})
+}
@@ -0,0 +1,319 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+const (
+	opSchema  = 0x03
+	opChannel = 0x04
+	opMessage = 0x05
+	opChunk   = 0x06
+	opDataEnd = 0x0F
+
+	// maxRecordLength bounds the records read into memory, so that a corrupt
+	// length does not allocate the whole address space.
+	maxRecordLength = 1 << 31
+)
+
+// Schema describes how the messages of a channel are encoded.
+type Schema struct {
+	ID       uint16
+	Name     string
+	Encoding string
+	Data     []byte
+}
+
+// Channel is a topic of a file. Schema is nil for schemaless channels.
+type Channel struct {
+	ID              uint16
+	Schema          *Schema
+	Topic           string
+	MessageEncoding string
+}
+
+// Message is a message of a channel, still encoded.
+type Message struct {
+	Channel     *Channel
+	Sequence    uint32
+	LogTime     time.Time
+	PublishTime time.Time
+	Data        []byte
+}
+
+// Reader reads the messages of an MCAP file in file order, in a single pass,
+// so that remote files can be streamed instead of downloaded.
+type Reader struct {
+	r        io.Reader
+	schemas  map[uint16]*Schema
+	channels map[uint16]*Channel
+	// chunk holds the records of the current chunk not read yet.
+	chunk []byte
+	zstd  *zstd.Decoder
+	done  bool
+}
+
+// NewReader checks the leading magic of r and returns a reader of its messages.
+func NewReader(r io.Reader) (*Reader, error) {
+	magic := make([]byte, len(Magic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return nil, fmt.Errorf("unable to read magic: %w", err)
+	}
+	if string(magic) != string(Magic) {
+		return nil, errors.New("not an mcap file: missing leading magic")
+	}
+	return &Reader{
+		r:        r,
+		schemas:  map[uint16]*Schema{},
+		channels: map[uint16]*Channel{},
+	}, nil
+}
+
+// Next returns the next message, or io.EOF after the last one. Files that
+// were not closed properly end at the last complete record.
+func (r *Reader) Next() (*Message, error) {
+	for {
+		op, content, err := r.nextRecord()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case opSchema:
+			s, err := parseSchema(content)
+			if err != nil {
+				return nil, err
+			}
+			r.schemas[s.ID] = s
+		case opChannel:
+			c, err := r.parseChannel(content)
+			if err != nil {
+				return nil, err
+			}
+			r.channels[c.ID] = c
+		case opMessage:
+			return r.parseMessage(content)
+		case opChunk:
+			if r.chunk, err = r.decompressChunk(content); err != nil {
+				return nil, err
+			}
+		case opDataEnd, opFooter:
+			r.done = true
+			r.chunk = nil
+			return nil, io.EOF
+		}
+	}
+}
+
+// Close releases the decompressor. It does not close the underlying reader.
+func (r *Reader) Close() {
+	if r.zstd != nil {
+		r.zstd.Close()
+	}
+}
+
+// nextRecord returns the next record of the current chunk, or else of the
+// data section. Records other than the ones Next handles are skipped
+// without being read into memory.
+func (r *Reader) nextRecord() (byte, []byte, error) {
+	if len(r.chunk) > 0 {
+		op, content, rest, err := nextRecord(r.chunk)
+		r.chunk = rest
+		return op, content, err
+	}
+	if r.done {
+		return 0, nil, io.EOF
+	}
+
+	var header [9]byte
+	if _, err := io.ReadFull(r.r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, nil, errors.New("truncated mcap record")
+		}
+		return 0, nil, err
+	}
+	op, length := header[0], binary.LittleEndian.Uint64(header[1:])
+
+	switch op {
+	case opSchema, opChannel, opMessage, opChunk, opDataEnd, opFooter:
+	default:
+		if _, err := io.CopyN(io.Discard, r.r, int64(length)); err != nil {
+			return 0, nil, fmt.Errorf("truncated mcap record: %w", err)
+		}
+		return op, nil, nil
+	}
+	if length > maxRecordLength {
+		return 0, nil, fmt.Errorf("mcap record of %d bytes is too large", length)
+	}
+	content := make([]byte, length)
+	if _, err := io.ReadFull(r.r, content); err != nil {
+		return 0, nil, fmt.Errorf("truncated mcap record: %w", err)
+	}
+	return op, content, nil
+}
+
+func (r *Reader) decompressChunk(content []byte) ([]byte, error) {
+	// message_start_time u64, message_end_time u64, uncompressed_size u64,
+	// uncompressed_crc u32, compression string, records bytes (u64 length).
+	f := fields{b: content}
+	f.skip(8 + 8)
+	size := f.u64()
+	f.skip(4)
+	compression := f.str()
+	records := f.bytes64()
+	if f.err != nil {
+		return nil, fmt.Errorf("invalid mcap chunk: %w", f.err)
+	}
+
+	if compression != "" && size > maxRecordLength {
+		return nil, fmt.Errorf("mcap chunk of %d bytes is too large", size)
+	}
+	switch compression {
+	case "":
+		return records, nil
+	case "zstd":
+		if r.zstd == nil {
+			d, err := zstd.NewReader(nil)
+			if err != nil {
+				return nil, err
+			}
+			r.zstd = d
+		}
+		out, err := r.zstd.DecodeAll(records, make([]byte, 0, size))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decompress mcap chunk: %w", err)
+		}
+		return out, nil
+	case "lz4":
+		// MCAP chunks hold an lz4 frame, not a raw block.
+		out := make([]byte, size)
+		if _, err := io.ReadFull(lz4.NewReader(bytes.NewReader(records)), out); err != nil {
+			return nil, fmt.Errorf("unable to decompress mcap chunk: %w", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported mcap chunk compression %q", compression)
+	}
+}
+
+func parseSchema(content []byte) (*Schema, error) {
+	f := fields{b: content}
+	s := &Schema{ID: f.u16(), Name: f.str(), Encoding: f.str(), Data: f.bytes32()}
+	if f.err != nil {
+		return nil, fmt.Errorf("invalid mcap schema: %w", f.err)
+	}
+	return s, nil
+}
+
+func (r *Reader) parseChannel(content []byte) (*Channel, error) {
+	f := fields{b: content}
+	c := &Channel{ID: f.u16()}
+	schemaID := f.u16()
+	c.Topic = f.str()
+	c.MessageEncoding = f.str()
+	if f.err != nil {
+		return nil, fmt.Errorf("invalid mcap channel: %w", f.err)
+	}
+	if schemaID != 0 {
+		if c.Schema = r.schemas[schemaID]; c.Schema == nil {
+			return nil, fmt.Errorf("mcap channel %s refers to unknown schema %d", c.Topic, schemaID)
+		}
+	}
+	return c, nil
+}
+
+func (r *Reader) parseMessage(content []byte) (*Message, error) {
+	f := fields{b: content}
+	channelID := f.u16()
+	m := &Message{
+		Sequence:    f.u32(),
+		LogTime:     nanosToTime(f.u64()),
+		PublishTime: nanosToTime(f.u64()),
+	}
+	if f.err != nil {
+		return nil, fmt.Errorf("invalid mcap message: %w", f.err)
+	}
+	m.Data = f.b
+	if m.Channel = r.channels[channelID]; m.Channel == nil {
+		return nil, fmt.Errorf("mcap message refers to unknown channel %d", channelID)
+	}
+	return m, nil
+}
+
+// fields reads the little-endian fields of a record. The first error sticks
+// and zero values are returned from then on.
+type fields struct {
+	b   []byte
+	err error
+}
+
+func (f *fields) next(n uint64) []byte {
+	if f.err != nil {
+		return nil
+	}
+	if n > uint64(len(f.b)) {
+		f.err = errors.New("truncated record")
+		return nil
+	}
+	v := f.b[:n]
+	f.b = f.b[n:]
+	return v
+}
+
+func (f *fields) skip(n uint64) {
+	f.next(n)
+}
+
+func (f *fields) u16() uint16 {
+	if b := f.next(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (f *fields) u32() uint32 {
+	if b := f.next(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (f *fields) u64() uint64 {
+	if b := f.next(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (f *fields) str() string {
+	return string(f.bytes32())
+}
+
+func (f *fields) bytes32() []byte {
+	return f.next(uint64(f.u32()))
+}
+
+func (f *fields) bytes64() []byte {
+	return f.next(f.u64())
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mcapString(b *bytes.Buffer, s string) {
+	_ = binary.Write(b, binary.LittleEndian, uint32(len(s)))
+	b.WriteString(s)
+}
+
+func schemaContent(id uint16, name, encoding string, data []byte) []byte {
+	var c bytes.Buffer
+	_ = binary.Write(&c, binary.LittleEndian, id)
+	mcapString(&c, name)
+	mcapString(&c, encoding)
+	mcapString(&c, string(data))
+	return c.Bytes()
+}
+
+func channelContent(id, schemaID uint16, topic, encoding string) []byte {
+	var c bytes.Buffer
+	_ = binary.Write(&c, binary.LittleEndian, id)
+	_ = binary.Write(&c, binary.LittleEndian, schemaID)
+	mcapString(&c, topic)
+	mcapString(&c, encoding)
+	_ = binary.Write(&c, binary.LittleEndian, uint32(0)) // empty metadata
+	return c.Bytes()
+}
+
+func messageContent(channelID uint16, seq uint32, logTime time.Time, data string) []byte {
+	var c bytes.Buffer
+	_ = binary.Write(&c, binary.LittleEndian, channelID)
+	_ = binary.Write(&c, binary.LittleEndian, seq)
+	_ = binary.Write(&c, binary.LittleEndian, uint64(logTime.UnixNano()))
+	_ = binary.Write(&c, binary.LittleEndian, uint64(logTime.UnixNano()))
+	c.WriteString(data)
+	return c.Bytes()
+}
+
+func chunkContent(t *testing.T, compression string, records []byte) []byte {
+	compressed := records
+	if compression == "zstd" {
+		enc, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		compressed = enc.EncodeAll(records, nil)
+		require.NoError(t, enc.Close())
+	}
+	if compression == "lz4" {
+		var buf bytes.Buffer
+		w := lz4.NewWriter(&buf)
+		_, err := w.Write(records)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		compressed = buf.Bytes()
+	}
+	return rawChunkContent(compression, len(records), compressed)
+}
+
+// rawChunkContent builds a chunk from records already compressed.
+func rawChunkContent(compression string, uncompressedSize int, compressed []byte) []byte {
+	var c bytes.Buffer
+	_ = binary.Write(&c, binary.LittleEndian, [2]uint64{}) // message start and end times
+	_ = binary.Write(&c, binary.LittleEndian, uint64(uncompressedSize))
+	_ = binary.Write(&c, binary.LittleEndian, uint32(0)) // crc, not checked
+	mcapString(&c, compression)
+	_ = binary.Write(&c, binary.LittleEndian, uint64(len(compressed)))
+	c.Write(compressed)
+	return c.Bytes()
+}
+
+func readAll(t *testing.T, data []byte) ([]*Message, error) {
+	r, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer r.Close()
+
+	var messages []*Message
+	for {
+		m, err := r.Next()
+		if err == io.EOF {
+			return messages, nil
+		} else if err != nil {
+			return messages, err
+		}
+		messages = append(messages, m)
+	}
+}
+
+func TestReader(t *testing.T) {
+	logTime := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("messages in and out of chunks", func(t *testing.T) {
+		var chunk fileBuilder
+		chunk.record(opMessage, messageContent(1, 2, logTime.Add(time.Second), `{"n":2}`))
+
+		for _, compression := range []string{"", "zstd", "lz4"} {
+			b := &fileBuilder{}
+			b.Write(Magic)
+			b.record(0x01, []byte{0, 0, 0, 0, 0, 0, 0, 0})
+			b.record(opSchema, schemaContent(1, "Event", "jsonschema", []byte("{}")))
+			b.record(opChannel, channelContent(1, 1, "/events", "json"))
+			b.record(0x09, []byte("an attachment, skipped"))
+			b.record(opMessage, messageContent(1, 1, logTime, `{"n":1}`))
+			b.record(opChunk, chunkContent(t, compression, chunk.Bytes()))
+			b.record(opDataEnd, []byte{0, 0, 0, 0})
+			b.record(opMessage, messageContent(1, 3, logTime, `{"n":3}`)) // not read
+
+			messages, err := readAll(t, b.Bytes())
+			require.NoError(t, err, compression)
+			require.Len(t, messages, 2, compression)
+			assert.Equal(t, "/events", messages[0].Channel.Topic)
+			assert.Equal(t, "Event", messages[0].Channel.Schema.Name)
+			assert.Equal(t, `{"n":1}`, string(messages[0].Data))
+			assert.True(t, logTime.Equal(messages[0].LogTime))
+			assert.Equal(t, uint32(2), messages[1].Sequence)
+			assert.Equal(t, `{"n":2}`, string(messages[1].Data))
+		}
+	})
+
+	t.Run("unfinished file ends at the last record", func(t *testing.T) {
+		b := &fileBuilder{}
+		b.Write(Magic)
+		b.record(opChannel, channelContent(1, 0, "/events", "json"))
+		b.record(opMessage, messageContent(1, 1, logTime, `{}`))
+
+		messages, err := readAll(t, b.Bytes())
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Nil(t, messages[0].Channel.Schema)
+	})
+
+	t.Run("truncated record", func(t *testing.T) {
+		b := &fileBuilder{}
+		b.Write(Magic)
+		b.record(opChannel, channelContent(1, 0, "/events", "json"))
+		data := b.Bytes()
+		_, err := readAll(t, data[:len(data)-3])
+		assert.ErrorContains(t, err, "truncated")
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		b := &fileBuilder{}
+		b.Write(Magic)
+		b.record(opMessage, messageContent(7, 1, logTime, `{}`))
+		_, err := readAll(t, b.Bytes())
+		assert.ErrorContains(t, err, "unknown channel 7")
+	})
+
+	t.Run("unsupported compression", func(t *testing.T) {
+		b := &fileBuilder{}
+		b.Write(Magic)
+		b.record(opChunk, chunkContent(t, "bz2", []byte("x")))
+		_, err := readAll(t, b.Bytes())
+		assert.ErrorContains(t, err, `unsupported mcap chunk compression "bz2"`)
+	})
+
+	t.Run("corrupt lz4 chunk", func(t *testing.T) {
+		b := &fileBuilder{}
+		b.Write(Magic)
+		b.record(opChunk, rawChunkContent("lz4", 16, []byte("not an lz4 frame")))
+		_, err := readAll(t, b.Bytes())
+		assert.ErrorContains(t, err, "unable to decompress mcap chunk")
+	})
+
+	t.Run("not an mcap file", func(t *testing.T) {
+		_, err := NewReader(bytes.NewReader([]byte("not an mcap file")))
+		assert.ErrorContains(t, err, "missing leading magic")
+	})
+}
@@ -0,0 +1,368 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcap
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// rosMaxDepth bounds the nesting of messages, which guards against schemas
+// that refer to themselves.
+const rosMaxDepth = 64
+
+var (
+	rosSectionSeparator = regexp.MustCompile(`(?m)^=+\s*$`)
+	rosArraySuffix      = regexp.MustCompile(`\[(<=)?(\d*)\]$`)
+)
+
+// rosField is a field of a ROS message definition.
+type rosField struct {
+	name string
+	// typ is a primitive type, or the package/Type name of a message.
+	typ string
+	// arrayLen is -1 for fields that are not arrays, 0 for variable length
+	// arrays and the length of fixed size arrays otherwise.
+	arrayLen int
+}
+
+// rosSchema is a parsed ros1msg or ros2msg schema: the root message and the
+// definitions of the messages it depends on.
+type rosSchema struct {
+	root string
+	defs map[string][]rosField
+	ros2 bool
+}
+
+// parseRosSchema parses the concatenated message definitions of an MCAP
+// ros1msg or ros2msg schema named name.
+func parseRosSchema(name string, text string, ros2 bool) (*rosSchema, error) {
+	s := &rosSchema{root: rosTypeName(name), defs: map[string][]rosField{}, ros2: ros2}
+
+	for i, section := range rosSectionSeparator.Split(text, -1) {
+		typeName := s.root
+		if i > 0 {
+			section = strings.TrimLeft(section, "\r\n")
+			header, rest, _ := strings.Cut(section, "\n")
+			header = strings.TrimSpace(header)
+			if !strings.HasPrefix(header, "MSG:") {
+				return nil, fmt.Errorf("invalid ros schema %s: expected a MSG: line, got %q", name, header)
+			}
+			typeName = rosTypeName(strings.TrimSpace(strings.TrimPrefix(header, "MSG:")))
+			section = rest
+		}
+		fields, err := parseRosFields(typeName, section, ros2)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ros schema %s: %w", name, err)
+		}
+		s.defs[typeName] = fields
+	}
+
+	for typeName, fields := range s.defs {
+		for _, f := range fields {
+			if !isRosPrimitive(f.typ, ros2) && s.defs[f.typ] == nil {
+				return nil, fmt.Errorf("invalid ros schema %s: %s.%s has unknown type %s", name, typeName, f.name, f.typ)
+			}
+		}
+	}
+	return s, nil
+}
+
+// rosTypeName normalizes pkg/msg/Type to pkg/Type.
+func rosTypeName(name string) string {
+	if pkg, rest, ok := strings.Cut(name, "/msg/"); ok {
+		return pkg + "/" + rest
+	}
+	return name
+}
+
+func parseRosFields(typeName string, definition string, ros2 bool) ([]rosField, error) {
+	pkg, _, _ := strings.Cut(typeName, "/")
+
+	var fields []rosField
+	for _, line := range strings.Split(definition, "\n") {
+		tokens := strings.Fields(line)
+		if len(tokens) == 0 || strings.HasPrefix(tokens[0], "#") {
+			continue
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("invalid field %q in %s", strings.TrimSpace(line), typeName)
+		}
+		// Constants are TYPE NAME=VALUE and are not serialized. Their value
+		// may contain a #, so they are recognized before comments are cut.
+		if strings.Contains(tokens[1], "=") || (len(tokens) > 2 && strings.HasPrefix(tokens[2], "=")) {
+			continue
+		}
+		code, _, _ := strings.Cut(line, "#")
+		if tokens = strings.Fields(code); len(tokens) < 2 {
+			return nil, fmt.Errorf("invalid field %q in %s", strings.TrimSpace(line), typeName)
+		}
+
+		f := rosField{name: tokens[1], typ: tokens[0], arrayLen: -1}
+		if m := rosArraySuffix.FindStringSubmatch(f.typ); m != nil {
+			f.typ = strings.TrimSuffix(f.typ, m[0])
+			f.arrayLen = 0
+			// Bounded arrays, T[<=N], are serialized as variable length ones.
+			if m[1] == "" && m[2] != "" {
+				n, err := strconv.Atoi(m[2])
+				if err != nil {
+					return nil, fmt.Errorf("invalid array length of %s.%s: %w", typeName, f.name, err)
+				}
+				f.arrayLen = n
+			}
+		}
+		// Bounded strings, string<=N, are serialized as strings.
+		if i := strings.Index(f.typ, "<="); i > 0 {
+			f.typ = f.typ[:i]
+		}
+
+		if !isRosPrimitive(f.typ, ros2) {
+			switch {
+			case f.typ == "Header" && !ros2:
+				f.typ = "std_msgs/Header"
+			case strings.Contains(f.typ, "/"):
+				f.typ = rosTypeName(f.typ)
+			default:
+				f.typ = pkg + "/" + f.typ
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func isRosPrimitive(typ string, ros2 bool) bool {
+	switch typ {
+	case "bool", "byte", "char", "int8", "uint8", "int16", "uint16", "int32", "uint32",
+		"int64", "uint64", "float32", "float64", "string":
+		return true
+	case "time", "duration":
+		return !ros2
+	case "wstring":
+		return ros2
+	}
+	return false
+}
+
+// decodeRos1 decodes a message serialized by ROS 1: little-endian, unaligned,
+// with uint32 lengths before strings and variable length arrays.
+func (s *rosSchema) decodeRos1(data []byte) (map[string]any, error) {
+	r := &rosReader{data: data, order: binary.LittleEndian}
+	return s.decode(r)
+}
+
+// decodeCdr decodes a message serialized as plain CDR, as ROS 2 does: a four
+// byte encapsulation header, then primitives aligned to their size.
+func (s *rosSchema) decodeCdr(data []byte) (map[string]any, error) {
+	if len(data) < 4 {
+		return nil, errors.New("truncated cdr message")
+	}
+	r := &rosReader{data: data[4:], cdr: true}
+	switch data[1] {
+	case 0x00:
+		r.order = binary.BigEndian
+	case 0x01:
+		r.order = binary.LittleEndian
+	default:
+		return nil, fmt.Errorf("unsupported cdr encapsulation kind %#x", data[1])
+	}
+	return s.decode(r)
+}
+
+func (s *rosSchema) decode(r *rosReader) (map[string]any, error) {
+	v, err := s.decodeMessage(r, s.root, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s message: %w", s.root, err)
+	}
+	return v, nil
+}
+
+func (s *rosSchema) decodeMessage(r *rosReader, typeName string, depth int) (map[string]any, error) {
+	if depth > rosMaxDepth {
+		return nil, errors.New("message is nested too deeply")
+	}
+	fields := s.defs[typeName]
+	v := make(map[string]any, len(fields))
+	for _, f := range fields {
+		value, err := s.decodeField(r, f, depth)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.name, err)
+		}
+		v[f.name] = value
+	}
+	return v, nil
+}
+
+func (s *rosSchema) decodeField(r *rosReader, f rosField, depth int) (any, error) {
+	if f.arrayLen < 0 {
+		return s.decodeValue(r, f.typ, depth)
+	}
+
+	n := f.arrayLen
+	if n == 0 {
+		count, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		if int(count) > len(r.data) {
+			return nil, errors.New("array length exceeds the message")
+		}
+		n = int(count)
+	}
+	// Byte arrays, e.g. image data, are kept as they are.
+	if f.typ == "uint8" || f.typ == "byte" || f.typ == "char" {
+		return r.next(n, 1)
+	}
+	values := make([]any, n)
+	for i := range values {
+		v, err := s.decodeValue(r, f.typ, depth)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func (s *rosSchema) decodeValue(r *rosReader, typ string, depth int) (any, error) {
+	switch typ {
+	case "bool":
+		b, err := r.next(1, 1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case "int8":
+		b, err := r.next(1, 1)
+		if err != nil {
+			return nil, err
+		}
+		return int64(int8(b[0])), nil
+	case "uint8", "byte", "char":
+		b, err := r.next(1, 1)
+		if err != nil {
+			return nil, err
+		}
+		return uint64(b[0]), nil
+	case "int16":
+		b, err := r.next(2, 2)
+		if err != nil {
+			return nil, err
+		}
+		return int64(int16(r.order.Uint16(b))), nil
+	case "uint16":
+		b, err := r.next(2, 2)
+		if err != nil {
+			return nil, err
+		}
+		return uint64(r.order.Uint16(b)), nil
+	case "int32":
+		v, err := r.uint32()
+		return int64(int32(v)), err
+	case "uint32":
+		v, err := r.uint32()
+		return uint64(v), err
+	case "int64":
+		b, err := r.next(8, 8)
+		if err != nil {
+			return nil, err
+		}
+		return int64(r.order.Uint64(b)), nil
+	case "uint64":
+		b, err := r.next(8, 8)
+		if err != nil {
+			return nil, err
+		}
+		return r.order.Uint64(b), nil
+	case "float32":
+		v, err := r.uint32()
+		return float64(math.Float32frombits(v)), err
+	case "float64":
+		b, err := r.next(8, 8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(r.order.Uint64(b)), nil
+	case "string":
+		return r.string()
+	case "wstring":
+		return nil, errors.New("wstring fields are not supported")
+	case "time", "duration":
+		sec, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		nsec, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		if typ == "duration" {
+			return map[string]any{"sec": int64(int32(sec)), "nsec": int64(int32(nsec))}, nil
+		}
+		return map[string]any{"sec": uint64(sec), "nsec": uint64(nsec)}, nil
+	default:
+		return s.decodeMessage(r, typ, depth+1)
+	}
+}
+
+// rosReader reads the primitives of a serialized ROS message. CDR aligns
+// primitives to their size, relative to the end of the encapsulation header.
+type rosReader struct {
+	data  []byte
+	pos   int
+	order binary.ByteOrder
+	cdr   bool
+}
+
+func (r *rosReader) next(n int, align int) ([]byte, error) {
+	if r.cdr && align > 1 {
+		r.pos = (r.pos + align - 1) &^ (align - 1)
+	}
+	if n < 0 || r.pos > len(r.data) || n > len(r.data)-r.pos {
+		return nil, errors.New("truncated message")
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *rosReader) uint32() (uint32, error) {
+	b, err := r.next(4, 4)
+	if err != nil {
+		return 0, err
+	}
+	return r.order.Uint32(b), nil
+}
+
+func (r *rosReader) string() (string, error) {
+	n, err := r.uint32()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.next(int(n), 1)
+	if err != nil {
+		return "", err
+	}
+	// CDR strings count and carry their terminating NUL.
+	if r.cdr {
+		b = []byte(strings.TrimSuffix(string(b), "\x00"))
+	}
+	return string(b), nil
+}
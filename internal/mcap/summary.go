@@ -0,0 +1,130 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mcap reads the parts of MCAP files (https://mcap.dev) cocli needs
+// without loading whole files, which are typically large and remote: the
+// summary statistics, and the messages in a single streaming pass.
+package mcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Magic starts and ends every MCAP file.
+var Magic = []byte{0x89, 'M', 'C', 'A', 'P', '0', '\r', '\n'}
+
+const (
+	opFooter     = 0x02
+	opStatistics = 0x0B
+
+	// footerLength is the length of the footer record: opcode, record length,
+	// summary start, summary offset start and summary crc.
+	footerLength = 1 + 8 + 8 + 8 + 4
+)
+
+// ErrNoStatistics is returned for files whose summary has no statistics,
+// e.g. files that were not closed properly.
+var ErrNoStatistics = errors.New("mcap file has no statistics in its summary")
+
+// Statistics summarizes the messages of a file.
+type Statistics struct {
+	MessageCount     uint64
+	MessageStartTime time.Time
+	MessageEndTime   time.Time
+}
+
+// ReadStatistics reads the statistics record from the summary section of an
+// MCAP file of the given size, reading only the footer and the summary.
+func ReadStatistics(r io.ReaderAt, size int64) (*Statistics, error) {
+	if size < int64(len(Magic)*2+footerLength) {
+		return nil, errors.New("file is too small to be an mcap file")
+	}
+
+	tail := make([]byte, footerLength+len(Magic))
+	if _, err := r.ReadAt(tail, size-int64(len(tail))); err != nil {
+		return nil, fmt.Errorf("unable to read footer: %w", err)
+	}
+	if !bytes.Equal(tail[footerLength:], Magic) {
+		return nil, errors.New("not an mcap file: missing trailing magic")
+	}
+	if tail[0] != opFooter {
+		return nil, errors.New("invalid mcap footer")
+	}
+	summaryStart := binary.LittleEndian.Uint64(tail[9:17])
+	summaryOffsetStart := binary.LittleEndian.Uint64(tail[17:25])
+	if summaryStart == 0 {
+		return nil, ErrNoStatistics
+	}
+
+	footerStart := uint64(size) - uint64(len(tail))
+	summaryEnd := footerStart
+	if summaryOffsetStart != 0 {
+		summaryEnd = summaryOffsetStart
+	}
+	if summaryStart > summaryEnd {
+		return nil, errors.New("invalid mcap footer: summary out of bounds")
+	}
+
+	summary := make([]byte, summaryEnd-summaryStart)
+	if _, err := r.ReadAt(summary, int64(summaryStart)); err != nil {
+		return nil, fmt.Errorf("unable to read summary: %w", err)
+	}
+
+	for len(summary) > 0 {
+		op, content, rest, err := nextRecord(summary)
+		if err != nil {
+			return nil, err
+		}
+		if op == opStatistics {
+			return parseStatistics(content)
+		}
+		summary = rest
+	}
+	return nil, ErrNoStatistics
+}
+
+// nextRecord splits the first record off data.
+func nextRecord(data []byte) (op byte, content []byte, rest []byte, err error) {
+	if len(data) < 9 {
+		return 0, nil, nil, errors.New("truncated mcap record")
+	}
+	length := binary.LittleEndian.Uint64(data[1:9])
+	if length > uint64(len(data)-9) {
+		return 0, nil, nil, errors.New("truncated mcap record")
+	}
+	return data[0], data[9 : 9+length], data[9+length:], nil
+}
+
+func parseStatistics(content []byte) (*Statistics, error) {
+	// message_count u64, schema_count u16, channel_count u32, attachment_count u32,
+	// metadata_count u32, chunk_count u32, message_start_time u64, message_end_time u64.
+	const length = 8 + 2 + 4 + 4 + 4 + 4 + 8 + 8
+	if len(content) < length {
+		return nil, errors.New("truncated mcap statistics record")
+	}
+	return &Statistics{
+		MessageCount:     binary.LittleEndian.Uint64(content[0:8]),
+		MessageStartTime: nanosToTime(binary.LittleEndian.Uint64(content[26:34])),
+		MessageEndTime:   nanosToTime(binary.LittleEndian.Uint64(content[34:42])),
+	}, nil
+}
+
+func nanosToTime(ns uint64) time.Time {
+	return time.Unix(0, int64(ns))
+}
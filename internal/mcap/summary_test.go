@@ -0,0 +1,113 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fileBuilder writes minimal MCAP files for tests.
+type fileBuilder struct {
+	bytes.Buffer
+}
+
+func (b *fileBuilder) record(op byte, content []byte) {
+	b.WriteByte(op)
+	_ = binary.Write(&b.Buffer, binary.LittleEndian, uint64(len(content)))
+	b.Write(content)
+}
+
+func statisticsContent(count uint64, start, end time.Time) []byte {
+	var c bytes.Buffer
+	_ = binary.Write(&c, binary.LittleEndian, count)
+	_ = binary.Write(&c, binary.LittleEndian, uint16(1))
+	_ = binary.Write(&c, binary.LittleEndian, uint32(1))
+	_ = binary.Write(&c, binary.LittleEndian, [3]uint32{}) // attachment, metadata and chunk counts
+	_ = binary.Write(&c, binary.LittleEndian, uint64(start.UnixNano()))
+	_ = binary.Write(&c, binary.LittleEndian, uint64(end.UnixNano()))
+	_ = binary.Write(&c, binary.LittleEndian, uint32(0)) // empty channel message counts
+	return c.Bytes()
+}
+
+// buildFile writes a file with the given summary records, or no summary.
+func buildFile(summary func(b *fileBuilder)) []byte {
+	b := &fileBuilder{}
+	b.Write(Magic)
+	b.record(0x01, []byte{0, 0, 0, 0, 0, 0, 0, 0}) // header with empty profile and library
+	b.record(0x0F, []byte{0, 0, 0, 0})             // data end
+
+	summaryStart := uint64(0)
+	if summary != nil {
+		summaryStart = uint64(b.Len())
+		summary(b)
+	}
+
+	var footer bytes.Buffer
+	_ = binary.Write(&footer, binary.LittleEndian, summaryStart)
+	_ = binary.Write(&footer, binary.LittleEndian, uint64(0))
+	_ = binary.Write(&footer, binary.LittleEndian, uint32(0))
+	b.record(opFooter, footer.Bytes())
+	b.Write(Magic)
+	return b.Bytes()
+}
+
+func TestReadStatistics(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 500, time.UTC)
+	end := start.Add(time.Minute)
+
+	t.Run("statistics after other summary records", func(t *testing.T) {
+		data := buildFile(func(b *fileBuilder) {
+			b.record(0x03, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}) // schema
+			b.record(opStatistics, statisticsContent(42, start, end))
+		})
+
+		stats, err := ReadStatistics(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), stats.MessageCount)
+		assert.True(t, start.Equal(stats.MessageStartTime))
+		assert.True(t, end.Equal(stats.MessageEndTime))
+	})
+
+	t.Run("no summary", func(t *testing.T) {
+		data := buildFile(nil)
+		_, err := ReadStatistics(bytes.NewReader(data), int64(len(data)))
+		assert.ErrorIs(t, err, ErrNoStatistics)
+	})
+
+	t.Run("summary without statistics", func(t *testing.T) {
+		data := buildFile(func(b *fileBuilder) {
+			b.record(0x03, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+		})
+		_, err := ReadStatistics(bytes.NewReader(data), int64(len(data)))
+		assert.ErrorIs(t, err, ErrNoStatistics)
+	})
+
+	t.Run("not an mcap file", func(t *testing.T) {
+		data := bytes.Repeat([]byte("x"), 100)
+		_, err := ReadStatistics(bytes.NewReader(data), int64(len(data)))
+		assert.ErrorContains(t, err, "not an mcap file")
+	})
+
+	t.Run("too small", func(t *testing.T) {
+		_, err := ReadStatistics(bytes.NewReader(Magic), int64(len(Magic)))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package name
+
+import (
+	"fmt"
+
+	"github.com/oriser/regroup"
+	"github.com/pkg/errors"
+)
+
+type Device struct {
+	DeviceID string
+}
+
+var (
+	deviceRe = regroup.MustCompile(`^devices/(?P<device>[^/]+)$`)
+)
+
+func NewDevice(device string) (*Device, error) {
+	if match, err := deviceRe.Groups(device); err != nil {
+		return nil, errors.Wrap(err, "parse device name")
+	} else {
+		return &Device{DeviceID: match["device"]}, nil
+	}
+}
+
+func (d Device) String() string {
+	return fmt.Sprintf("devices/%s", d.DeviceID)
+}
@@ -241,6 +241,33 @@ func TestNewUser(t *testing.T) {
 	}
 }
 
+func TestNewDevice(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantID  string
+		wantErr bool
+	}{
+		{"valid", "devices/d-123", "d-123", false},
+		{"extra path", "devices/d-123/records/r1", "", true},
+		{"empty device id", "devices/", "", true},
+		{"invalid prefix", "robots/d-123", "", true},
+		{"empty", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDevice(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, d.DeviceID)
+			assert.Equal(t, tt.input, d.String())
+		})
+	}
+}
+
 func TestNewProjectFile(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -290,3 +317,32 @@ func TestIsUUID(t *testing.T) {
 		})
 	}
 }
+
+func TestNewEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantProj  string
+		wantEvent string
+		wantErr   bool
+	}{
+		{"valid", "projects/p1/events/e1", "p1", "e1", false},
+		{"record event path", "projects/p1/records/r1/events/e1", "", "", true},
+		{"missing events segment", "projects/p1/e1", "", "", true},
+		{"empty string", "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewEvent(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProj, e.ProjectID)
+			assert.Equal(t, tt.wantEvent, e.EventID)
+			assert.Equal(t, tt.input, e.String())
+			assert.Equal(t, tt.wantProj, e.Project().ProjectID)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"slices"
+	"strings"
+
+	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	customFieldNameTrimSize     = 30
+	customFieldTypeTrimSize     = 6
+	customFieldMultipleTrimSize = 8
+	customFieldOptionsTrimSize  = 80
+)
+
+type CustomFieldSchema struct {
+	Delegate *commons.CustomFieldSchema
+}
+
+func NewCustomFieldSchema(schema *commons.CustomFieldSchema) *CustomFieldSchema {
+	return &CustomFieldSchema{Delegate: schema}
+}
+
+func (p *CustomFieldSchema) ToProtoMessage() proto.Message {
+	return p.Delegate
+}
+
+func (p *CustomFieldSchema) ToTable(opts *table.PrintOpts) table.Table {
+	fullColumnDefs := []table.ColumnDefinitionFull[*commons.Property]{
+		{
+			FieldName: "NAME",
+			FieldValueFunc: func(prop *commons.Property, opts *table.PrintOpts) string {
+				return prop.Name
+			},
+			TrimSize: customFieldNameTrimSize,
+		},
+		{
+			FieldName: "TYPE",
+			FieldValueFunc: func(prop *commons.Property, opts *table.PrintOpts) string {
+				return customFieldType(prop)
+			},
+			TrimSize: customFieldTypeTrimSize,
+		},
+		{
+			FieldName: "MULTIPLE",
+			FieldValueFunc: func(prop *commons.Property, opts *table.PrintOpts) string {
+				if prop.GetEnums().GetMultiple() || prop.GetUser().GetMultiple() {
+					return "yes"
+				}
+				return "no"
+			},
+			TrimSize: customFieldMultipleTrimSize,
+		},
+		{
+			FieldName: "OPTIONS",
+			FieldValueFunc: func(prop *commons.Property, opts *table.PrintOpts) string {
+				return customFieldOptions(prop)
+			},
+			TrimSize: customFieldOptionsTrimSize,
+		},
+	}
+
+	return table.ColumnDefs2Table(fullColumnDefs, p.Delegate.GetProperties(), opts)
+}
+
+func customFieldType(prop *commons.Property) string {
+	switch prop.GetType().(type) {
+	case *commons.Property_Text:
+		return "text"
+	case *commons.Property_Number:
+		return "number"
+	case *commons.Property_Enums:
+		return "enum"
+	case *commons.Property_Time:
+		return "time"
+	case *commons.Property_User:
+		return "user"
+	default:
+		return "unknown"
+	}
+}
+
+// customFieldOptions describes the values a field accepts: the enum display
+// names, or how users are referenced.
+func customFieldOptions(prop *commons.Property) string {
+	switch prop.GetType().(type) {
+	case *commons.Property_Enums:
+		values := lo.Values(prop.GetEnums().GetValues())
+		slices.Sort(values)
+		return strings.Join(values, ", ")
+	case *commons.Property_User:
+		if prop.GetUser().GetMultiple() {
+			return "user nicknames, separated by ;"
+		}
+		return "user nickname"
+	case *commons.Property_Time:
+		return "RFC3339, YYYY-MM-DDTHH:MM or YYYY-MM-DD"
+	default:
+		return ""
+	}
+}
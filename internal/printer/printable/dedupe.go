@@ -0,0 +1,103 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"strconv"
+
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/internal/printer/utils"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	dedupeShaTrimSize    = 12
+	dedupeSizeTrimSize   = 12
+	dedupeCopiesTrimSize = 6
+	dedupeRecordTrimSize = 36
+	dedupePathTrimSize   = 60
+)
+
+// DedupeReport lists the content stored more than once in a project, one row
+// per copy so that it can be filtered as CSV.
+type DedupeReport struct {
+	Delegate []*filestats.DuplicateSet
+}
+
+func NewDedupeReport(dups []*filestats.DuplicateSet) *DedupeReport {
+	return &DedupeReport{Delegate: dups}
+}
+
+// TotalWasted returns the bytes freed by keeping a single copy of everything.
+func (p *DedupeReport) TotalWasted() int64 {
+	return lo.SumBy(p.Delegate, func(d *filestats.DuplicateSet) int64 { return d.Wasted() })
+}
+
+func (p *DedupeReport) ToProtoMessage() proto.Message {
+	data := map[string]any{
+		"wastedBytes": float64(p.TotalWasted()),
+		"duplicates": lo.Map(p.Delegate, func(d *filestats.DuplicateSet, _ int) any {
+			return map[string]any{
+				"sha256":      d.Sha256,
+				"size":        float64(d.Size),
+				"wastedBytes": float64(d.Wasted()),
+				"copies": lo.Map(d.Copies, func(f filestats.File, _ int) any {
+					return map[string]any{"record": f.Owner, "path": f.Path}
+				}),
+			}
+		}),
+	}
+	s, _ := structpb.NewStruct(data)
+	return s
+}
+
+func (p *DedupeReport) ToTable(opts *table.PrintOpts) table.Table {
+	var rows [][]string
+	for _, d := range p.Delegate {
+		sha := d.Sha256
+		if !opts.Verbose && len(sha) > dedupeShaTrimSize {
+			sha = sha[:dedupeShaTrimSize]
+		}
+		for _, f := range d.Copies {
+			rows = append(rows, []string{
+				sha,
+				utils.FormatBytes(uint64(d.Size)),
+				strconv.Itoa(len(d.Copies)),
+				utils.FormatBytes(uint64(d.Wasted())),
+				f.Owner,
+				f.Path,
+			})
+		}
+	}
+
+	shaTrimSize := dedupeShaTrimSize
+	if opts.Verbose {
+		shaTrimSize = 64
+	}
+	return table.Table{
+		ColumnDefs: []table.ColumnDefinition{
+			{FieldName: "SHA256", TrimSize: shaTrimSize},
+			{FieldName: "SIZE", TrimSize: dedupeSizeTrimSize},
+			{FieldName: "COPIES", TrimSize: dedupeCopiesTrimSize},
+			{FieldName: "WASTED", TrimSize: dedupeSizeTrimSize},
+			{FieldName: "RECORD", TrimSize: dedupeRecordTrimSize},
+			{FieldName: "PATH", TrimSize: dedupePathTrimSize},
+		},
+		Rows: rows,
+	}
+}
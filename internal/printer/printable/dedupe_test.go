@@ -0,0 +1,55 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"testing"
+
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDedupeReport(t *testing.T) {
+	dups := filestats.Duplicates([]filestats.File{
+		{Owner: "rec-1", Path: "raw/a.bag", Size: 1024, Sha256: "0123456789abcdef"},
+		{Owner: "rec-2", Path: "a.bag", Size: 1024, Sha256: "0123456789abcdef"},
+	})
+	report := NewDedupeReport(dups)
+	assert.Equal(t, int64(1024), report.TotalWasted())
+
+	t.Run("table", func(t *testing.T) {
+		tbl := report.ToTable(&table.PrintOpts{})
+		assert.Equal(t, [][]string{
+			{"0123456789ab", "1.00 KB", "2", "1.00 KB", "rec-1", "raw/a.bag"},
+			{"0123456789ab", "1.00 KB", "2", "1.00 KB", "rec-2", "a.bag"},
+		}, tbl.Rows)
+	})
+
+	t.Run("verbose table keeps the full hash", func(t *testing.T) {
+		tbl := report.ToTable(&table.PrintOpts{Verbose: true})
+		require.NotEmpty(t, tbl.Rows)
+		assert.Equal(t, "0123456789abcdef", tbl.Rows[0][0])
+	})
+
+	t.Run("proto message", func(t *testing.T) {
+		st, ok := report.ToProtoMessage().(*structpb.Struct)
+		require.True(t, ok)
+		assert.Equal(t, float64(1024), st.Fields["wastedBytes"].GetNumberValue())
+		require.Len(t, st.Fields["duplicates"].GetListValue().GetValues(), 1)
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"strings"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	deviceIdTrimSize          = 36
+	deviceSerialTrimSize      = 25
+	deviceDisplayNameTrimSize = 30
+	deviceTimeTrimSize        = len(time.RFC3339)
+)
+
+type Device struct {
+	Delegate []*openv1alpha1resource.Device
+}
+
+func NewDevice(devices []*openv1alpha1resource.Device) *Device {
+	return &Device{Delegate: devices}
+}
+
+func (p *Device) ToProtoMessage() proto.Message {
+	return &openv1alpha1service.ListOrganizationDevicesResponse{
+		Devices:   p.Delegate,
+		TotalSize: int64(len(p.Delegate)),
+	}
+}
+
+func (p *Device) ToTable(opts *table.PrintOpts) table.Table {
+	fullColumnDefs := []table.ColumnDefinitionFull[*openv1alpha1resource.Device]{
+		{
+			FieldNameFunc: func(opts *table.PrintOpts) string {
+				if opts.Verbose {
+					return "RESOURCE NAME"
+				}
+				return "ID"
+			},
+			FieldValueFunc: func(d *openv1alpha1resource.Device, opts *table.PrintOpts) string {
+				if opts.Verbose {
+					return d.Name
+				}
+				return d.Name[strings.LastIndex(d.Name, "/")+1:]
+			},
+			TrimSize: deviceIdTrimSize,
+		},
+		{
+			FieldName: "SERIAL NUMBER",
+			FieldValueFunc: func(d *openv1alpha1resource.Device, opts *table.PrintOpts) string {
+				return d.SerialNumber
+			},
+			TrimSize: deviceSerialTrimSize,
+		},
+		{
+			FieldName: "DISPLAY NAME",
+			FieldValueFunc: func(d *openv1alpha1resource.Device, opts *table.PrintOpts) string {
+				return d.DisplayName
+			},
+			TrimSize: deviceDisplayNameTrimSize,
+		},
+		{
+			FieldName: "CREATE TIME",
+			FieldValueFunc: func(d *openv1alpha1resource.Device, opts *table.PrintOpts) string {
+				if d.CreateTime == nil {
+					return ""
+				}
+				return d.CreateTime.AsTime().In(time.Local).Format(time.RFC3339)
+			},
+			TrimSize: deviceTimeTrimSize,
+		},
+	}
+
+	return table.ColumnDefs2Table(fullColumnDefs, p.Delegate, opts)
+}
+
+// SingleDevice prints the details of one device as a two-column table.
+type SingleDevice struct {
+	Delegate *openv1alpha1resource.Device
+}
+
+func NewSingleDevice(device *openv1alpha1resource.Device) *SingleDevice {
+	return &SingleDevice{Delegate: device}
+}
+
+func (p *SingleDevice) ToProtoMessage() proto.Message {
+	return p.Delegate
+}
+
+func (p *SingleDevice) ToTable(opts *table.PrintOpts) table.Table {
+	d := p.Delegate
+	createTime := ""
+	if d.CreateTime != nil {
+		createTime = d.CreateTime.AsTime().In(time.Local).Format(time.RFC3339)
+	}
+	rows := [][]string{
+		{"NAME", d.Name},
+		{"SERIAL NUMBER", d.SerialNumber},
+		{"DISPLAY NAME", d.DisplayName},
+		{"DESCRIPTION", d.Description},
+		{"CREATE TIME", createTime},
+	}
+
+	columnDefs := []table.ColumnDefinition{
+		{FieldName: "FIELD", TrimSize: 20},
+		{FieldName: "VALUE", TrimSize: 120},
+	}
+
+	return table.Table{
+		ColumnDefs: columnDefs,
+		Rows:       rows,
+	}
+}
@@ -19,11 +19,13 @@ import (
 
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
+	"github.com/coscene-io/cocli/internal/name"
 	"github.com/coscene-io/cocli/internal/printer/table"
 	"google.golang.org/protobuf/proto"
 )
 
 const (
+	eventIdTrimSize   = 36
 	eventNameTrimSize = 20
 	eventTimeTrimSize = len(time.RFC3339)
 )
@@ -47,6 +49,25 @@ func (p *Event) ToProtoMessage() proto.Message {
 
 func (p *Event) ToTable(opts *table.PrintOpts) table.Table {
 	fullColumnDefs := []table.ColumnDefinitionFull[*openv1alpha1resource.Event]{
+		{
+			FieldNameFunc: func(opts *table.PrintOpts) string {
+				if opts.Verbose {
+					return "RESOURCE NAME"
+				}
+				return "ID"
+			},
+			FieldValueFunc: func(e *openv1alpha1resource.Event, opts *table.PrintOpts) string {
+				if opts.Verbose {
+					return e.Name
+				}
+				eventName, err := name.NewEvent(e.Name)
+				if err != nil {
+					return e.Name
+				}
+				return eventName.EventID
+			},
+			TrimSize: eventIdTrimSize,
+		},
 		{
 			FieldName: "NAME",
 			FieldValueFunc: func(e *openv1alpha1resource.Event, opts *table.PrintOpts) string {
@@ -0,0 +1,133 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"github.com/coscene-io/cocli/internal/filediff"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/internal/printer/utils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	fileDiffStatusTrimSize = 10
+	fileDiffPathTrimSize   = 50
+	fileDiffSizeTrimSize   = 12
+	fileDiffShaTrimSize    = 12
+)
+
+// FileDiff is the result of comparing the file sets of A and B.
+type FileDiff struct {
+	A        string
+	B        string
+	Delegate []*filediff.Entry
+}
+
+func NewFileDiff(a, b string, entries []*filediff.Entry) *FileDiff {
+	return &FileDiff{
+		A:        a,
+		B:        b,
+		Delegate: entries,
+	}
+}
+
+func (p *FileDiff) ToProtoMessage() proto.Message {
+	entries := make([]any, 0, len(p.Delegate))
+	for _, e := range p.Delegate {
+		entry := map[string]any{
+			"path":   e.Path,
+			"status": string(e.Status),
+		}
+		if e.A != nil {
+			entry["a"] = map[string]any{"sha256": e.A.Sha256, "size": float64(e.A.Size)}
+		}
+		if e.B != nil {
+			entry["b"] = map[string]any{"sha256": e.B.Sha256, "size": float64(e.B.Size)}
+		}
+		entries = append(entries, entry)
+	}
+	data, _ := structpb.NewStruct(map[string]any{
+		"a":       p.A,
+		"b":       p.B,
+		"entries": entries,
+	})
+	return data
+}
+
+func (p *FileDiff) ToTable(opts *table.PrintOpts) table.Table {
+	size := func(d *filediff.Digest) string {
+		if d == nil {
+			return "-"
+		}
+		return utils.FormatBytes(uint64(d.Size))
+	}
+	sha := func(d *filediff.Digest, opts *table.PrintOpts) string {
+		if d == nil {
+			return "-"
+		}
+		if !opts.Verbose && len(d.Sha256) > fileDiffShaTrimSize {
+			return d.Sha256[:fileDiffShaTrimSize]
+		}
+		return d.Sha256
+	}
+
+	fullColumnDefs := []table.ColumnDefinitionFull[*filediff.Entry]{
+		{
+			FieldName: "STATUS",
+			FieldValueFunc: func(e *filediff.Entry, opts *table.PrintOpts) string {
+				return string(e.Status)
+			},
+			TrimSize: fileDiffStatusTrimSize,
+		},
+		{
+			FieldName: "PATH",
+			FieldValueFunc: func(e *filediff.Entry, opts *table.PrintOpts) string {
+				return e.Path
+			},
+			TrimSize: fileDiffPathTrimSize,
+		},
+		{
+			FieldName: "SIZE A",
+			FieldValueFunc: func(e *filediff.Entry, opts *table.PrintOpts) string {
+				return size(e.A)
+			},
+			TrimSize: fileDiffSizeTrimSize,
+		},
+		{
+			FieldName: "SIZE B",
+			FieldValueFunc: func(e *filediff.Entry, opts *table.PrintOpts) string {
+				return size(e.B)
+			},
+			TrimSize: fileDiffSizeTrimSize,
+		},
+		{
+			FieldName: "SHA256 A",
+			FieldValueFunc: func(e *filediff.Entry, opts *table.PrintOpts) string {
+				return sha(e.A, opts)
+			},
+			TrimSize: 64,
+		},
+		{
+			FieldName: "SHA256 B",
+			FieldValueFunc: func(e *filediff.Entry, opts *table.PrintOpts) string {
+				return sha(e.B, opts)
+			},
+			TrimSize: 64,
+		},
+	}
+
+	return table.ColumnDefs2Table(fullColumnDefs, p.Delegate, opts)
+}
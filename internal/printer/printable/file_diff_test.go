@@ -0,0 +1,57 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"testing"
+
+	"github.com/coscene-io/cocli/internal/filediff"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestFileDiff(t *testing.T) {
+	entries := []*filediff.Entry{
+		{Path: "a.bag", Status: filediff.Differ, A: &filediff.Digest{Sha256: "0123456789abcdef", Size: 1024}, B: &filediff.Digest{Sha256: "fedcba9876543210", Size: 1024}},
+		{Path: "b.bag", Status: filediff.OnlyInB, B: &filediff.Digest{Sha256: "ffff", Size: 1}},
+	}
+	p := NewFileDiff("rec-a", "rec-b", entries)
+
+	t.Run("table", func(t *testing.T) {
+		tbl := p.ToTable(&table.PrintOpts{})
+		require.Len(t, tbl.Rows, 2)
+		assert.Equal(t, []string{"differ", "a.bag", "1.00 KB", "1.00 KB", "0123456789ab", "fedcba987654"}, tbl.Rows[0])
+		assert.Equal(t, []string{"only-in-b", "b.bag", "-", "1 B", "-", "ffff"}, tbl.Rows[1])
+	})
+
+	t.Run("verbose shows full sha256", func(t *testing.T) {
+		tbl := p.ToTable(&table.PrintOpts{Verbose: true})
+		assert.Equal(t, "0123456789abcdef", tbl.Rows[0][4])
+	})
+
+	t.Run("proto message", func(t *testing.T) {
+		st, ok := p.ToProtoMessage().(*structpb.Struct)
+		require.True(t, ok)
+		assert.Equal(t, "rec-a", st.Fields["a"].GetStringValue())
+		list := st.Fields["entries"].GetListValue().GetValues()
+		require.Len(t, list, 2)
+		second := list[1].GetStructValue().Fields
+		assert.Equal(t, "only-in-b", second["status"].GetStringValue())
+		assert.Nil(t, second["a"])
+		assert.Equal(t, float64(1), second["b"].GetStructValue().Fields["size"].GetNumberValue())
+	})
+}
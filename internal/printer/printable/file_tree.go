@@ -0,0 +1,81 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"strconv"
+
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/internal/printer/utils"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	fileTreeNameTrimSize  = 80
+	fileTreeCountTrimSize = 8
+	fileTreeSizeTrimSize  = 12
+)
+
+// FileTree is a directory tree of files with per-directory counts and sizes.
+type FileTree struct {
+	Root *filestats.TreeNode
+}
+
+func NewFileTree(root *filestats.TreeNode) *FileTree {
+	return &FileTree{Root: root}
+}
+
+func (p *FileTree) ToProtoMessage() proto.Message {
+	s, _ := structpb.NewStruct(treeNodeToMap(p.Root))
+	return s
+}
+
+func (p *FileTree) ToTable(opts *table.PrintOpts) table.Table {
+	rows := lo.Map(p.Root.Lines(), func(l filestats.TreeLine, _ int) []string {
+		files := ""
+		if l.Node.Dir {
+			files = strconv.FormatInt(l.Node.Files, 10)
+		}
+		nodeName := l.Node.Name
+		if opts.Verbose {
+			nodeName = l.Path
+		}
+		return []string{l.Prefix + nodeName, files, utils.FormatBytes(uint64(l.Node.Bytes))}
+	})
+
+	return table.Table{
+		ColumnDefs: []table.ColumnDefinition{
+			{FieldName: "NAME", TrimSize: fileTreeNameTrimSize},
+			{FieldName: "FILES", TrimSize: fileTreeCountTrimSize},
+			{FieldName: "SIZE", TrimSize: fileTreeSizeTrimSize},
+		},
+		Rows: rows,
+	}
+}
+
+func treeNodeToMap(n *filestats.TreeNode) map[string]any {
+	if !n.Dir {
+		return map[string]any{"name": n.Name, "bytes": float64(n.Bytes)}
+	}
+	return map[string]any{
+		"name":     n.Name,
+		"files":    float64(n.Files),
+		"bytes":    float64(n.Bytes),
+		"children": lo.Map(n.Children, func(c *filestats.TreeNode, _ int) any { return treeNodeToMap(c) }),
+	}
+}
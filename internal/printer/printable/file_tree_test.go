@@ -0,0 +1,63 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"testing"
+
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestFileTree(t *testing.T) {
+	tree := NewFileTree(filestats.BuildTree([]filestats.File{
+		{Path: "raw/a.bag", Size: 1024},
+		{Path: "README", Size: 1024},
+	}))
+
+	t.Run("table", func(t *testing.T) {
+		tbl := tree.ToTable(&table.PrintOpts{})
+		assert.Equal(t, [][]string{
+			{"/", "2", "2.00 KB"},
+			{"├── raw/", "1", "1.00 KB"},
+			{"│   └── a.bag", "", "1.00 KB"},
+			{"└── README", "", "1.00 KB"},
+		}, tbl.Rows)
+	})
+
+	t.Run("verbose table shows full paths", func(t *testing.T) {
+		tbl := tree.ToTable(&table.PrintOpts{Verbose: true})
+		assert.Equal(t, [][]string{
+			{"/", "2", "2.00 KB"},
+			{"├── raw/", "1", "1.00 KB"},
+			{"│   └── raw/a.bag", "", "1.00 KB"},
+			{"└── README", "", "1.00 KB"},
+		}, tbl.Rows)
+	})
+
+	t.Run("proto message is nested", func(t *testing.T) {
+		st, ok := tree.ToProtoMessage().(*structpb.Struct)
+		require.True(t, ok)
+		assert.Equal(t, float64(2), st.Fields["files"].GetNumberValue())
+		children := st.Fields["children"].GetListValue().GetValues()
+		require.Len(t, children, 2)
+		raw := children[0].GetStructValue()
+		assert.Equal(t, "raw/", raw.Fields["name"].GetStringValue())
+		assert.Len(t, raw.Fields["children"].GetListValue().GetValues(), 1)
+	})
+}
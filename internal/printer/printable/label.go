@@ -0,0 +1,75 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"strings"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	openv1alpha1service "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/services"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	labelIdTrimSize          = 36
+	labelDisplayNameTrimSize = 50
+)
+
+type Label struct {
+	Delegate []*openv1alpha1resource.Label
+}
+
+func NewLabel(labels []*openv1alpha1resource.Label) *Label {
+	return &Label{
+		Delegate: labels,
+	}
+}
+
+func (p *Label) ToProtoMessage() proto.Message {
+	return &openv1alpha1service.ListLabelsResponse{
+		Labels:    p.Delegate,
+		TotalSize: int64(len(p.Delegate)),
+	}
+}
+
+func (p *Label) ToTable(opts *table.PrintOpts) table.Table {
+	fullColumnDefs := []table.ColumnDefinitionFull[*openv1alpha1resource.Label]{
+		{
+			FieldNameFunc: func(opts *table.PrintOpts) string {
+				if opts.Verbose {
+					return "RESOURCE NAME"
+				}
+				return "ID"
+			},
+			FieldValueFunc: func(l *openv1alpha1resource.Label, opts *table.PrintOpts) string {
+				if opts.Verbose {
+					return l.Name
+				}
+				return l.Name[strings.LastIndex(l.Name, "/")+1:]
+			},
+			TrimSize: labelIdTrimSize,
+		},
+		{
+			FieldName: "DISPLAY NAME",
+			FieldValueFunc: func(l *openv1alpha1resource.Label, opts *table.PrintOpts) string {
+				return l.DisplayName
+			},
+			TrimSize: labelDisplayNameTrimSize,
+		},
+	}
+
+	return table.ColumnDefs2Table(fullColumnDefs, p.Delegate, opts)
+}
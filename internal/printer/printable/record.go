@@ -160,6 +160,13 @@ func (p *Record) ToTable(opts *table.PrintOpts) table.Table {
 			},
 			TrimSize: fileSizeTrimSize,
 		},
+		{
+			FieldName: "DEVICE",
+			FieldValueFunc: func(r *openv1alpha1resource.Record, opts *table.PrintOpts) string {
+				return recordDevice(r)
+			},
+			TrimSize: recordTitleTrimSize,
+		},
 	}
 
 	if opts.Wide {
@@ -169,16 +176,6 @@ func (p *Record) ToTable(opts *table.PrintOpts) table.Table {
 	// CSV-only columns: shown only in csv output
 	if opts.CSV {
 		csvOnlyDefs := []table.ColumnDefinitionFull[*openv1alpha1resource.Record]{
-			{
-				FieldName: "DEVICE",
-				FieldValueFunc: func(r *openv1alpha1resource.Record, opts *table.PrintOpts) string {
-					if r.Device != nil {
-						return r.Device.SerialNumber
-					}
-					return ""
-				},
-				TrimSize: recordTitleTrimSize,
-			},
 			{
 				FieldName: "DESCRIPTION",
 				FieldValueFunc: func(r *openv1alpha1resource.Record, opts *table.PrintOpts) string {
@@ -222,6 +219,18 @@ func (p *Record) ToTable(opts *table.PrintOpts) table.Table {
 	return table.ColumnDefs2Table(fullColumnDefs, p.Delegate, opts)
 }
 
+// recordDevice identifies the record's device by serial number, falling back
+// to the device id.
+func recordDevice(r *openv1alpha1resource.Record) string {
+	if r.Device == nil {
+		return ""
+	}
+	if r.Device.SerialNumber != "" {
+		return r.Device.SerialNumber
+	}
+	return r.Device.Name[strings.LastIndex(r.Device.Name, "/")+1:]
+}
+
 func csvCustomFieldColumnOrder(schemaOrder []string, records []*openv1alpha1resource.Record) []string {
 	if schemaOrder == nil {
 		return collectCustomFieldColumns(records)
@@ -0,0 +1,205 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	recordMomentRecordTrimSize = 36
+	recordMomentNameTrimSize   = 20
+	recordMomentTimeTrimSize   = len(time.RFC3339Nano)
+	recordMomentValueTrimSize  = 20
+
+	recordMomentAttributePrefix   = "attribute."
+	recordMomentCustomFieldPrefix = "custom."
+)
+
+// RecordMoment is a moment together with the record it belongs to.
+type RecordMoment struct {
+	RecordID    string
+	RecordTitle string
+	Moment      *api.Moment
+}
+
+// RecordMoments lists moments across records. Wide and CSV output add the
+// description and one attribute.<key> or custom.<field> column per attribute
+// and custom field seen, in the column layout read by "record moment import".
+type RecordMoments struct {
+	Delegate []*RecordMoment
+}
+
+func NewRecordMoments(moments []*RecordMoment) *RecordMoments {
+	return &RecordMoments{Delegate: moments}
+}
+
+// ToProtoMessages returns one message per moment, as printed in NDJSON output.
+func (p *RecordMoments) ToProtoMessages() []proto.Message {
+	return lo.Map(p.Delegate, func(m *RecordMoment, _ int) proto.Message {
+		s, _ := structpb.NewStruct(recordMomentData(m))
+		return s
+	})
+}
+
+func (p *RecordMoments) ToProtoMessage() proto.Message {
+	data := map[string]any{
+		"moments": lo.Map(p.Delegate, func(m *RecordMoment, _ int) any {
+			return recordMomentData(m)
+		}),
+	}
+	s, _ := structpb.NewStruct(data)
+	return s
+}
+
+// recordMomentData converts a moment to structpb compatible values, going
+// through its JSON encoding so that field names match moments.json.
+func recordMomentData(m *RecordMoment) map[string]any {
+	data := map[string]any{}
+	if raw, err := json.Marshal(m.Moment); err == nil {
+		_ = json.Unmarshal(raw, &data)
+	}
+	data["recordId"] = m.RecordID
+	data["recordTitle"] = m.RecordTitle
+	return data
+}
+
+func (p *RecordMoments) ToTable(opts *table.PrintOpts) table.Table {
+	fullColumnDefs := []table.ColumnDefinitionFull[*RecordMoment]{
+		{
+			FieldName: "RECORD ID",
+			FieldValueFunc: func(m *RecordMoment, opts *table.PrintOpts) string {
+				return m.RecordID
+			},
+			TrimSize: recordMomentRecordTrimSize,
+		},
+		{
+			FieldName: "RECORD TITLE",
+			FieldValueFunc: func(m *RecordMoment, opts *table.PrintOpts) string {
+				return m.RecordTitle
+			},
+			TrimSize: recordTitleTrimSize,
+		},
+		{
+			FieldName: "NAME",
+			FieldValueFunc: func(m *RecordMoment, opts *table.PrintOpts) string {
+				return m.Moment.Name
+			},
+			TrimSize: recordMomentNameTrimSize,
+		},
+		{
+			FieldName: "TRIGGER TIME",
+			FieldValueFunc: func(m *RecordMoment, opts *table.PrintOpts) string {
+				return m.Moment.TriggerTime
+			},
+			TrimSize: recordMomentTimeTrimSize,
+		},
+		{
+			FieldName: "DURATION",
+			FieldValueFunc: func(m *RecordMoment, opts *table.PrintOpts) string {
+				if d, err := time.ParseDuration(m.Moment.Duration); err == nil {
+					return d.String()
+				}
+				return m.Moment.Duration
+			},
+			TrimSize: recordMomentValueTrimSize,
+		},
+	}
+
+	if opts.Wide {
+		fullColumnDefs = append(fullColumnDefs, table.ColumnDefinitionFull[*RecordMoment]{
+			FieldName: "DESCRIPTION",
+			FieldValueFunc: func(m *RecordMoment, opts *table.PrintOpts) string {
+				return m.Moment.Description
+			},
+			TrimSize: recordTitleTrimSize,
+		})
+
+		attributes, customFields := p.valueColumns()
+		for _, key := range attributes {
+			fullColumnDefs = append(fullColumnDefs, table.ColumnDefinitionFull[*RecordMoment]{
+				FieldName: recordMomentAttributePrefix + key,
+				FieldValueFunc: func(m *RecordMoment, opts *table.PrintOpts) string {
+					return m.Moment.Attribute[key]
+				},
+				TrimSize: recordMomentValueTrimSize,
+			})
+		}
+		for _, field := range customFields {
+			fullColumnDefs = append(fullColumnDefs, table.ColumnDefinitionFull[*RecordMoment]{
+				FieldName: recordMomentCustomFieldPrefix + field,
+				FieldValueFunc: func(m *RecordMoment, opts *table.PrintOpts) string {
+					for _, cfv := range m.Moment.CustomFieldValues {
+						if v, ok := cfv[field]; ok {
+							return formatMomentValue(v, multiValueSep(opts))
+						}
+					}
+					return ""
+				},
+				TrimSize: recordMomentValueTrimSize,
+			})
+		}
+	}
+
+	return table.ColumnDefs2Table(fullColumnDefs, p.Delegate, opts)
+}
+
+// valueColumns returns the attribute keys and custom field names of the
+// moments, in the order they are first seen.
+func (p *RecordMoments) valueColumns() (attributes []string, customFields []string) {
+	for _, m := range p.Delegate {
+		keys := lo.Keys(m.Moment.Attribute)
+		// Map order is random, keep the columns of a moment stable.
+		slices.Sort(keys)
+		for _, k := range keys {
+			if !lo.Contains(attributes, k) {
+				attributes = append(attributes, k)
+			}
+		}
+		for _, cfv := range m.Moment.CustomFieldValues {
+			for field := range cfv {
+				if !lo.Contains(customFields, field) {
+					customFields = append(customFields, field)
+				}
+			}
+		}
+	}
+	return attributes, customFields
+}
+
+// formatMomentValue formats a custom field value of api.Moment.
+func formatMomentValue(v any, sep string) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []string:
+		return strings.Join(v, sep)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"testing"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRecordMoments(t *testing.T) {
+	moments := NewRecordMoments([]*RecordMoment{
+		{
+			RecordID:    "r1",
+			RecordTitle: "drive 1",
+			Moment: &api.Moment{
+				Name:              "brake",
+				Description:       "hard brake",
+				TriggerTime:       "2025-01-01T00:00:00Z",
+				Duration:          "1.500000000s",
+				Attribute:         map[string]string{"source": "lidar"},
+				CustomFieldValues: []map[string]any{{"speed": 12.5}, {"owners": []string{"alice", "bob"}}},
+			},
+		},
+		{
+			RecordID:    "r2",
+			RecordTitle: "drive 2",
+			Moment: &api.Moment{
+				Name:        "stop",
+				TriggerTime: "2025-01-02T00:00:00Z",
+				Duration:    "1.000000000s",
+				Attribute:   map[string]string{},
+			},
+		},
+	})
+
+	t.Run("table", func(t *testing.T) {
+		tbl := moments.ToTable(&table.PrintOpts{})
+		assert.Equal(t, [][]string{
+			{"r1", "drive 1", "brake", "2025-01-01T00:00:00Z", "1.5s"},
+			{"r2", "drive 2", "stop", "2025-01-02T00:00:00Z", "1s"},
+		}, tbl.Rows)
+	})
+
+	t.Run("csv adds value columns", func(t *testing.T) {
+		tbl := moments.ToTable(&table.PrintOpts{Wide: true, CSV: true})
+		var headers []string
+		for _, c := range tbl.ColumnDefs {
+			headers = append(headers, c.FieldName)
+		}
+		assert.Equal(t, []string{"RECORD ID", "RECORD TITLE", "NAME", "TRIGGER TIME", "DURATION", "DESCRIPTION", "attribute.source", "custom.speed", "custom.owners"}, headers)
+		assert.Equal(t, []string{"r1", "drive 1", "brake", "2025-01-01T00:00:00Z", "1.5s", "hard brake", "lidar", "12.5", "alice;bob"}, tbl.Rows[0])
+		assert.Equal(t, []string{"r2", "drive 2", "stop", "2025-01-02T00:00:00Z", "1s", "", "", "", ""}, tbl.Rows[1])
+	})
+
+	t.Run("proto messages", func(t *testing.T) {
+		msgs := moments.ToProtoMessages()
+		require.Len(t, msgs, 2)
+		st, ok := msgs[0].(*structpb.Struct)
+		require.True(t, ok)
+		assert.Equal(t, "r1", st.Fields["recordId"].GetStringValue())
+		assert.Equal(t, "brake", st.Fields["name"].GetStringValue())
+		assert.Equal(t, "lidar", st.Fields["attribute"].GetStructValue().Fields["source"].GetStringValue())
+		assert.Len(t, st.Fields["customFieldValues"].GetListValue().GetValues(), 2)
+
+		all, ok := moments.ToProtoMessage().(*structpb.Struct)
+		require.True(t, ok)
+		assert.Len(t, all.Fields["moments"].GetListValue().GetValues(), 2)
+	})
+}
@@ -21,6 +21,7 @@ import (
 	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -242,13 +243,28 @@ func TestRecord_ToTable_Wide(t *testing.T) {
 	assert.Contains(t, headers, "CREATOR")
 	assert.Contains(t, headers, "BYTE SIZE")
 	assert.Contains(t, headers, "PLAY DURATION")
+	assert.Contains(t, headers, "DEVICE")
 
-	assert.NotContains(t, headers, "DEVICE")
 	assert.NotContains(t, headers, "DESCRIPTION")
 	assert.NotContains(t, headers, "FILE COUNT")
 	assert.NotContains(t, headers, "FILES DURATION")
 }
 
+func TestRecord_ToTable_Device(t *testing.T) {
+	withSerial := makeTestRecord("r1", "rec1", nil)
+	withSerial.Device = &openv1alpha1resource.Device{Name: "devices/d1", SerialNumber: "R12"}
+	withoutSerial := makeTestRecord("r2", "rec2", nil)
+	withoutSerial.Device = &openv1alpha1resource.Device{Name: "devices/d2"}
+	noDevice := makeTestRecord("r3", "rec3", nil)
+
+	tbl := NewRecord([]*openv1alpha1resource.Record{withSerial, withoutSerial, noDevice}, "").ToTable(&table.PrintOpts{Wide: true})
+	deviceIdx := lo.IndexOf(getHeaders(tbl), "DEVICE")
+	require.NotEqual(t, -1, deviceIdx)
+	assert.Equal(t, "R12", tbl.Rows[0][deviceIdx])
+	assert.Equal(t, "d2", tbl.Rows[1][deviceIdx])
+	assert.Equal(t, "", tbl.Rows[2][deviceIdx])
+}
+
 func TestRecord_ToTable_CSV(t *testing.T) {
 	records := []*openv1alpha1resource.Record{
 		makeTestRecord("r1", "rec1", []*commons.CustomFieldValue{
@@ -273,10 +289,9 @@ func TestRecord_ToTable_CSV(t *testing.T) {
 	tbl := p.ToTable(&table.PrintOpts{Wide: true, CSV: true})
 	headers := getHeaders(tbl)
 
-	assert.Contains(t, headers, "DEVICE")
-	assert.Contains(t, headers, "DESCRIPTION")
-	assert.Contains(t, headers, "FILE COUNT")
-	assert.Contains(t, headers, "FILES DURATION")
+	// The fixed csv columns keep their order, as scripts read them by position.
+	assert.Equal(t, []string{"BYTE SIZE", "PLAY DURATION", "DEVICE", "DESCRIPTION", "FILE COUNT", "FILES DURATION"},
+		headers[lo.IndexOf(headers, "BYTE SIZE"):lo.IndexOf(headers, "FILES DURATION")+1])
 	assert.Contains(t, headers, "color")
 	assert.Contains(t, headers, "size")
 	require.Len(t, tbl.Rows, 2)
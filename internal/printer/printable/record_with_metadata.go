@@ -21,6 +21,7 @@ import (
 	"time"
 
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/internal/filestats"
 	"github.com/coscene-io/cocli/internal/name"
 	"github.com/coscene-io/cocli/internal/printer/table"
 	"github.com/coscene-io/cocli/internal/printer/utils"
@@ -35,6 +36,8 @@ import (
 type RecordWithMetadata struct {
 	Record *openv1alpha1resource.Record
 	URL    string
+	// Stats is the file statistics of the record, nil unless requested.
+	Stats *filestats.Stats
 }
 
 func NewRecordWithMetadata(record *openv1alpha1resource.Record, url string) *RecordWithMetadata {
@@ -65,6 +68,9 @@ func (r *RecordWithMetadata) ToProtoMessage() proto.Message {
 	if r.URL != "" {
 		data["url"] = r.URL
 	}
+	if r.Stats != nil {
+		data["stats"] = statsToMap(r.Stats)
+	}
 
 	s, err := structpb.NewStruct(data)
 	if err != nil {
@@ -142,6 +148,10 @@ func (r *RecordWithMetadata) ToTable(opts *table.PrintOpts) table.Table {
 		rows = append(rows, []string{"URL:", r.URL})
 	}
 
+	if r.Stats != nil {
+		rows = append(rows, fileStatsRows(r.Stats)...)
+	}
+
 	// Create column definitions
 	columnDefs := []table.ColumnDefinition{
 		{FieldName: "Field", TrimSize: 20},
@@ -0,0 +1,154 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	savedSearchNameTrimSize     = 30
+	savedSearchFieldTrimSize    = 20
+	savedSearchCriteriaTrimSize = 100
+)
+
+// SavedSearches is a list of saved record selections.
+type SavedSearches struct {
+	Delegate []*config.SavedSearch
+}
+
+func NewSavedSearches(searches []*config.SavedSearch) *SavedSearches {
+	return &SavedSearches{Delegate: searches}
+}
+
+func (p *SavedSearches) ToProtoMessage() proto.Message {
+	s, _ := structpb.NewStruct(map[string]any{
+		"savedSearches": lo.Map(p.Delegate, func(s *config.SavedSearch, _ int) any { return savedSearchToMap(s) }),
+	})
+	return s
+}
+
+func (p *SavedSearches) ToTable(opts *table.PrintOpts) table.Table {
+	fullColumnDefs := []table.ColumnDefinitionFull[*config.SavedSearch]{
+		{
+			FieldName: "NAME",
+			FieldValueFunc: func(s *config.SavedSearch, opts *table.PrintOpts) string {
+				return s.Name
+			},
+			TrimSize: savedSearchNameTrimSize,
+		},
+		{
+			FieldName: "CRITERIA",
+			FieldValueFunc: func(s *config.SavedSearch, opts *table.PrintOpts) string {
+				return strings.Join(lo.Map(savedSearchCriteria(s), func(c [2]string, _ int) string {
+					return c[0] + "=" + c[1]
+				}), " ")
+			},
+			TrimSize: savedSearchCriteriaTrimSize,
+		},
+	}
+
+	return table.ColumnDefs2Table(fullColumnDefs, p.Delegate, opts)
+}
+
+// SavedSearch is a single saved record selection.
+type SavedSearch struct {
+	Delegate *config.SavedSearch
+}
+
+func NewSavedSearch(search *config.SavedSearch) *SavedSearch {
+	return &SavedSearch{Delegate: search}
+}
+
+func (p *SavedSearch) ToProtoMessage() proto.Message {
+	s, _ := structpb.NewStruct(savedSearchToMap(p.Delegate))
+	return s
+}
+
+func (p *SavedSearch) ToTable(opts *table.PrintOpts) table.Table {
+	rows := [][]string{{"name", p.Delegate.Name}}
+	for _, c := range savedSearchCriteria(p.Delegate) {
+		rows = append(rows, []string{c[0], c[1]})
+	}
+
+	columnDefs := []table.ColumnDefinition{
+		{FieldName: "FIELD", TrimSize: savedSearchFieldTrimSize},
+		{FieldName: "VALUE", TrimSize: savedSearchCriteriaTrimSize},
+	}
+
+	return table.Table{
+		ColumnDefs: columnDefs,
+		Rows:       rows,
+	}
+}
+
+// savedSearchCriteria lists the set fields of a saved search as flag/value pairs.
+func savedSearchCriteria(s *config.SavedSearch) [][2]string {
+	var ret [][2]string
+	add := func(flag, value string) {
+		if value != "" {
+			ret = append(ret, [2]string{flag, value})
+		}
+	}
+	add("search", s.Search)
+	add("labels", strings.Join(s.Labels, ","))
+	add("keywords", strings.Join(s.Keywords, ","))
+	if s.IncludeArchive {
+		add("include-archive", strconv.FormatBool(s.IncludeArchive))
+	}
+	add("created-after", s.CreatedAfter)
+	add("created-before", s.CreatedBefore)
+	add("updated-since", s.UpdatedSince)
+	add("creator", strings.Join(s.Creators, ","))
+	add("device", strings.Join(s.Devices, ","))
+	add("custom", strings.Join(s.CustomFields, ","))
+	add("has-file", strings.Join(s.HasFiles, ","))
+	return ret
+}
+
+func savedSearchToMap(s *config.SavedSearch) map[string]any {
+	m := map[string]any{"name": s.Name}
+	str := func(key, value string) {
+		if value != "" {
+			m[key] = value
+		}
+	}
+	list := func(key string, values []string) {
+		if len(values) > 0 {
+			m[key] = lo.ToAnySlice(values)
+		}
+	}
+	str("search", s.Search)
+	list("labels", s.Labels)
+	list("keywords", s.Keywords)
+	if s.IncludeArchive {
+		m["includeArchive"] = true
+	}
+	str("createdAfter", s.CreatedAfter)
+	str("createdBefore", s.CreatedBefore)
+	str("updatedSince", s.UpdatedSince)
+	list("creators", s.Creators)
+	list("devices", s.Devices)
+	list("customFields", s.CustomFields)
+	list("hasFiles", s.HasFiles)
+	return m
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/internal/printer/utils"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	usageKindTrimSize  = 10
+	usageKeyTrimSize   = 60
+	usageCountTrimSize = 10
+	usageSizeTrimSize  = 12
+)
+
+// ProjectUsage is the storage report of a project.
+type ProjectUsage struct {
+	GroupBy string
+	Total   *filestats.Group
+	Groups  []*filestats.Group
+	// Files is the file level breakdown, nil unless requested.
+	Files *filestats.Stats
+}
+
+func NewProjectUsage(groupBy string, total *filestats.Group, groups []*filestats.Group, files *filestats.Stats) *ProjectUsage {
+	return &ProjectUsage{
+		GroupBy: groupBy,
+		Total:   total,
+		Groups:  groups,
+		Files:   files,
+	}
+}
+
+func (p *ProjectUsage) ToProtoMessage() proto.Message {
+	data := map[string]any{
+		"total": groupToMap(p.Total),
+	}
+	if p.GroupBy != "" {
+		data["groupBy"] = p.GroupBy
+		data["groups"] = lo.Map(p.Groups, func(g *filestats.Group, _ int) any { return groupToMap(g) })
+	}
+	if p.Files != nil {
+		data["files"] = statsToMap(p.Files)
+	}
+	s, _ := structpb.NewStruct(data)
+	return s
+}
+
+func (p *ProjectUsage) ToTable(opts *table.PrintOpts) table.Table {
+	rows := [][]string{
+		{"total", "-", strconv.Itoa(p.Total.Records), strconv.FormatInt(p.Total.Files, 10), utils.FormatBytes(uint64(p.Total.Bytes))},
+	}
+	for _, g := range p.Groups {
+		rows = append(rows, []string{p.GroupBy, g.Key, strconv.Itoa(g.Records), strconv.FormatInt(g.Files, 10), utils.FormatBytes(uint64(g.Bytes))})
+	}
+	if p.Files != nil {
+		for _, g := range p.Files.ByExtension {
+			rows = append(rows, []string{"extension", g.Key, "-", strconv.FormatInt(g.Files, 10), utils.FormatBytes(uint64(g.Bytes))})
+		}
+		for _, g := range p.Files.ByDirectory {
+			rows = append(rows, []string{"directory", g.Key, "-", strconv.FormatInt(g.Files, 10), utils.FormatBytes(uint64(g.Bytes))})
+		}
+		for _, f := range p.Files.Largest {
+			rows = append(rows, []string{"largest", f.Owner + "/" + f.Path, "-", "1", utils.FormatBytes(uint64(f.Size))})
+		}
+	}
+
+	return table.Table{
+		ColumnDefs: []table.ColumnDefinition{
+			{FieldName: "KIND", TrimSize: usageKindTrimSize},
+			{FieldName: "KEY", TrimSize: usageKeyTrimSize},
+			{FieldName: "RECORDS", TrimSize: usageCountTrimSize},
+			{FieldName: "FILES", TrimSize: usageCountTrimSize},
+			{FieldName: "SIZE", TrimSize: usageSizeTrimSize},
+		},
+		Rows: rows,
+	}
+}
+
+// fileStatsRows renders file statistics as rows of the two-column record table.
+func fileStatsRows(stats *filestats.Stats) [][]string {
+	groups := func(gs []*filestats.Group) string {
+		return strings.Join(lo.Map(gs, func(g *filestats.Group, _ int) string {
+			return fmt.Sprintf("%s: %d files, %s", g.Key, g.Files, utils.FormatBytes(uint64(g.Bytes)))
+		}), "; ")
+	}
+	return [][]string{
+		{"Stats Files:", strconv.FormatInt(stats.FileCount, 10)},
+		{"Stats Bytes:", utils.FormatBytes(uint64(stats.TotalBytes))},
+		{"Largest Files:", strings.Join(lo.Map(stats.Largest, func(f filestats.File, _ int) string {
+			return fmt.Sprintf("%s (%s)", f.Path, utils.FormatBytes(uint64(f.Size)))
+		}), ", ")},
+		{"By Extension:", groups(stats.ByExtension)},
+		{"By Directory:", groups(stats.ByDirectory)},
+	}
+}
+
+func statsToMap(stats *filestats.Stats) map[string]any {
+	return map[string]any{
+		"fileCount":  float64(stats.FileCount),
+		"totalBytes": float64(stats.TotalBytes),
+		"largest": lo.Map(stats.Largest, func(f filestats.File, _ int) any {
+			m := map[string]any{"path": f.Path, "size": float64(f.Size)}
+			if f.Owner != "" {
+				m["owner"] = f.Owner
+			}
+			return m
+		}),
+		"byExtension": lo.Map(stats.ByExtension, func(g *filestats.Group, _ int) any { return groupToMap(g) }),
+		"byDirectory": lo.Map(stats.ByDirectory, func(g *filestats.Group, _ int) any { return groupToMap(g) }),
+	}
+}
+
+func groupToMap(g *filestats.Group) map[string]any {
+	m := map[string]any{
+		"files": float64(g.Files),
+		"bytes": float64(g.Bytes),
+	}
+	if g.Key != "" {
+		m["key"] = g.Key
+	}
+	if g.Records > 0 {
+		m["records"] = float64(g.Records)
+	}
+	return m
+}
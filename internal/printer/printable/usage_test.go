@@ -0,0 +1,60 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printable
+
+import (
+	"testing"
+
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestProjectUsage(t *testing.T) {
+	total := &filestats.Group{Records: 3, Files: 12, Bytes: 2048}
+	groups := []*filestats.Group{
+		{Key: "2026-01", Records: 2, Files: 10, Bytes: 1024},
+		{Key: "2026-02", Records: 1, Files: 2, Bytes: 1024},
+	}
+
+	t.Run("table without files", func(t *testing.T) {
+		tbl := NewProjectUsage("month", total, groups, nil).ToTable(&table.PrintOpts{})
+		require.Len(t, tbl.Rows, 3)
+		assert.Equal(t, []string{"total", "-", "3", "12", "2.00 KB"}, tbl.Rows[0])
+		assert.Equal(t, []string{"month", "2026-01", "2", "10", "1.00 KB"}, tbl.Rows[1])
+	})
+
+	t.Run("table with files", func(t *testing.T) {
+		files := filestats.Compute([]filestats.File{{Owner: "rec-1", Path: "raw/a.bag", Size: 1024}}, 5)
+		tbl := NewProjectUsage("", total, nil, files).ToTable(&table.PrintOpts{})
+		assert.Equal(t, [][]string{
+			{"total", "-", "3", "12", "2.00 KB"},
+			{"extension", ".bag", "-", "1", "1.00 KB"},
+			{"directory", "raw/", "-", "1", "1.00 KB"},
+			{"largest", "rec-1/raw/a.bag", "-", "1", "1.00 KB"},
+		}, tbl.Rows)
+	})
+
+	t.Run("proto message", func(t *testing.T) {
+		st, ok := NewProjectUsage("month", total, groups, nil).ToProtoMessage().(*structpb.Struct)
+		require.True(t, ok)
+		assert.Equal(t, "month", st.Fields["groupBy"].GetStringValue())
+		assert.Equal(t, float64(2048), st.Fields["total"].GetStructValue().Fields["bytes"].GetNumberValue())
+		assert.Len(t, st.Fields["groups"].GetListValue().GetValues(), 2)
+		assert.Nil(t, st.Fields["files"])
+	})
+}
@@ -53,4 +53,7 @@ type PrintOpts struct {
 	// CSV indicates output is destined for CSV format.
 	// Multi-value fields should use ";" instead of ", " as separator.
 	CSV bool
+
+	// NoHeader suppresses the header row, e.g. when rows are streamed in batches.
+	NoHeader bool
 }
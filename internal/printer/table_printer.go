@@ -37,19 +37,21 @@ func (p *TablePrinter) PrintObj(obj printable.Interface, w io.Writer) (err error
 }
 
 func (p *TablePrinter) printFixed(t table.Table, w io.Writer) (err error) {
-	for _, columnDef := range t.ColumnDefs {
-		fieldName := columnDef.FieldName
-		if columnDef.FieldNameFunc != nil {
-			fieldName = columnDef.FieldNameFunc(p.Opts)
+	if !p.Opts.NoHeader {
+		for _, columnDef := range t.ColumnDefs {
+			fieldName := columnDef.FieldName
+			if columnDef.FieldNameFunc != nil {
+				fieldName = columnDef.FieldNameFunc(p.Opts)
+			}
+			format := getColumnFormat(p.Opts.Verbose, columnDef.TrimSize, fieldName)
+			if _, err = fmt.Fprintf(w, format, fieldName); err != nil {
+				return err
+			}
 		}
-		format := getColumnFormat(p.Opts.Verbose, columnDef.TrimSize, fieldName)
-		if _, err = fmt.Fprintf(w, format, fieldName); err != nil {
+		if _, err = fmt.Fprintln(w); err != nil {
 			return err
 		}
 	}
-	if _, err = fmt.Fprintln(w); err != nil {
-		return err
-	}
 
 	for _, row := range t.Rows {
 		for idx, columnDef := range t.ColumnDefs {
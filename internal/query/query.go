@@ -0,0 +1,460 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package query compiles the cocli record query language into the JSON Logic
+// documents accepted by the record search APIs.
+//
+// A query is a boolean expression of comparisons:
+//
+//	labels:"night" AND custom.weather=rain AND created>2026-01-01
+//
+// Comparisons take the form <field><op><value>, where op is one of
+// : = != > >= < <= and ":" means "contains". Comparisons combine with AND,
+// OR, NOT and parentheses. Values may be quoted to include spaces or
+// operator characters. Like the typed record filters, a query skips archived
+// records unless it compares the archived field itself.
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/coscene-io/cocli/internal/utils"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// CustomPrefix introduces a custom field, e.g. custom.weather.
+const CustomPrefix = "custom."
+
+type valueKind int
+
+const (
+	kindString valueKind = iota
+	kindTime
+	kindBool
+)
+
+type field struct {
+	variable string
+	kind     valueKind
+}
+
+// fields maps query field names to JSON Logic variables.
+var fields = map[string]field{
+	"title":       {"title", kindString},
+	"description": {"description", kindString},
+	"labels":      {"labels", kindString},
+	"creator":     {"creator", kindString},
+	"device":      {"device", kindString},
+	"created":     {"create_time", kindTime},
+	"updated":     {"update_time", kindTime},
+	"archived":    {"isArchived", kindBool},
+}
+
+// operators maps query operators to JSON Logic operators, longest first.
+var operators = []struct {
+	token string
+	logic string
+}{
+	{">=", ">="},
+	{"<=", "<="},
+	{"!=", "!="},
+	{":", "in"},
+	{"=", "=="},
+	{">", ">"},
+	{"<", "<"},
+}
+
+// Options customizes compilation.
+type Options struct {
+	// ResolveCustom maps a custom field name and raw value to the JSON Logic
+	// variable and the stored value, e.g. a property id and an enum id. When
+	// nil, custom fields compile to customFields.<name> with the raw value.
+	ResolveCustom func(name, value string) (variable string, resolved any, err error)
+
+	// ResolveLabel, ResolveCreator and ResolveDevice map a label display
+	// name, a user nickname and a device id or serial number to the ids
+	// stored on records. When nil, the raw value is used.
+	ResolveLabel   func(displayName string) (id string, err error)
+	ResolveCreator func(nickname string) (id string, err error)
+	ResolveDevice  func(device string) (id string, err error)
+
+	// Now anchors relative times such as created>7d. Defaults to time.Now().
+	Now time.Time
+
+	// IncludeArchived drops the implicit archived=false condition added to
+	// queries that do not mention archived.
+	IncludeArchived bool
+}
+
+// Error is a query error at a 1-based column.
+type Error struct {
+	Column int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Column, e.Msg)
+}
+
+// IsJSON reports whether search is a raw JSON Logic document rather than a
+// query. JSON Logic documents are objects, so anything else is a query.
+func IsJSON(search string) bool {
+	return strings.HasPrefix(strings.TrimSpace(search), "{")
+}
+
+// Compile parses src and returns the equivalent JSON Logic document.
+func Compile(src string, opts *Options) (*structpb.Struct, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	p := &parser{src: src, opts: opts, now: now}
+	p.skipSpace()
+	if p.eof() {
+		return nil, p.errorf(p.pos, "empty query")
+	}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.eof() {
+		return nil, p.errorf(p.pos, "unexpected %q, expected AND, OR or end of query", p.peekToken())
+	}
+	if !p.archived && !opts.IncludeArchived {
+		notArchived := map[string]any{"==": []any{map[string]any{"var": fields["archived"].variable}, false}}
+		if and, ok := node["and"].([]any); ok {
+			node["and"] = append(and, notArchived)
+		} else {
+			node = map[string]any{"and": []any{node, notArchived}}
+		}
+	}
+	return structpb.NewStruct(node)
+}
+
+type parser struct {
+	src  string
+	pos  int
+	opts *Options
+	now  time.Time
+
+	// archived is set once the query compares the archived field.
+	archived bool
+}
+
+func (p *parser) parseOr() (map[string]any, error) {
+	return p.parseChain("OR", "or", p.parseAnd)
+}
+
+func (p *parser) parseAnd() (map[string]any, error) {
+	return p.parseChain("AND", "and", p.parseUnary)
+}
+
+// parseChain parses operands joined by keyword into a single JSON Logic node.
+func (p *parser) parseChain(keyword, logic string, operand func() (map[string]any, error)) (map[string]any, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []any{first}
+	for p.keyword(keyword) {
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, next)
+	}
+	if len(nodes) == 1 {
+		return first, nil
+	}
+	return map[string]any{logic: nodes}, nil
+}
+
+func (p *parser) parseUnary() (map[string]any, error) {
+	if p.keyword("NOT") {
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"!": []any{node}}, nil
+	}
+
+	p.skipSpace()
+	if p.peek() == '(' {
+		open := p.pos
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.peek() != ')' {
+			return nil, p.errorf(open, "unclosed parenthesis")
+		}
+		p.pos++
+		return node, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (map[string]any, error) {
+	p.skipSpace()
+	fieldPos := p.pos
+	for !p.eof() && isFieldRune(p.peek()) {
+		p.pos++
+	}
+	name := p.src[fieldPos:p.pos]
+	if name == "" {
+		if p.eof() {
+			return nil, p.errorf(p.pos, "unexpected end of query, expected a field")
+		}
+		return nil, p.errorf(p.pos, "unexpected %q, expected a field", p.peekToken())
+	}
+
+	p.skipSpace()
+	opPos := p.pos
+	op := ""
+	for _, o := range operators {
+		if strings.HasPrefix(p.src[p.pos:], o.token) {
+			op = o.token
+			p.pos += len(o.token)
+			break
+		}
+	}
+	if op == "" {
+		return nil, p.errorf(opPos, "expected an operator (: = != > >= < <=) after %q", name)
+	}
+
+	p.skipSpace()
+	valuePos := p.pos
+	raw, quoted, err := p.readValue()
+	if err != nil {
+		return nil, err
+	}
+	if raw == "" && !quoted {
+		return nil, p.errorf(valuePos, "expected a value after %q", name+op)
+	}
+
+	variable, value, err := p.resolve(name, fieldPos, op, opPos, raw, quoted, valuePos)
+	if err != nil {
+		return nil, err
+	}
+
+	logic := ""
+	for _, o := range operators {
+		if o.token == op {
+			logic = o.logic
+		}
+	}
+	v := map[string]any{"var": variable}
+	if op == ":" {
+		return map[string]any{logic: []any{value, v}}, nil
+	}
+	return map[string]any{logic: []any{v, value}}, nil
+}
+
+// resolve returns the JSON Logic variable and typed value for a comparison.
+func (p *parser) resolve(name string, fieldPos int, op string, opPos int, raw string, quoted bool, valuePos int) (string, any, error) {
+	if strings.HasPrefix(name, CustomPrefix) {
+		key := strings.TrimPrefix(name, CustomPrefix)
+		if key == "" {
+			return "", nil, p.errorf(fieldPos, "missing custom field name after %q", CustomPrefix)
+		}
+		if p.opts.ResolveCustom == nil {
+			return "customFields." + key, literal(raw, quoted), nil
+		}
+		variable, value, err := p.opts.ResolveCustom(key, raw)
+		if err != nil {
+			return "", nil, p.errorf(fieldPos, "%v", err)
+		}
+		return variable, value, nil
+	}
+
+	f, ok := fields[name]
+	if !ok {
+		known := make([]string, 0, len(fields))
+		for k := range fields {
+			known = append(known, k)
+		}
+		sort.Strings(known)
+		return "", nil, p.errorf(fieldPos, "unknown field %q, expected one of %s or %s<name>", name, strings.Join(known, ", "), CustomPrefix)
+	}
+
+	if name == "archived" {
+		p.archived = true
+	}
+
+	switch f.kind {
+	case kindTime:
+		if op == ":" {
+			return "", nil, p.errorf(opPos, "operator %q is not supported for %s", op, name)
+		}
+		t, err := utils.ParseTimeOrAgo(raw, p.now)
+		if err != nil {
+			return "", nil, p.errorf(valuePos, "%v", err)
+		}
+		return f.variable, t.UTC().Format(time.RFC3339), nil
+	case kindBool:
+		if op != "=" && op != "!=" {
+			return "", nil, p.errorf(opPos, "operator %q is not supported for %s", op, name)
+		}
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return "", nil, p.errorf(valuePos, "invalid boolean %q", raw)
+		}
+		return f.variable, b, nil
+	default:
+		resolve := p.valueResolver(name)
+		if resolve == nil {
+			return f.variable, raw, nil
+		}
+		id, err := resolve(raw)
+		if err != nil {
+			return "", nil, p.errorf(valuePos, "%v", err)
+		}
+		return f.variable, id, nil
+	}
+}
+
+// valueResolver returns the resolver configured for a field, or nil.
+func (p *parser) valueResolver(name string) func(string) (string, error) {
+	switch name {
+	case "labels":
+		return p.opts.ResolveLabel
+	case "creator":
+		return p.opts.ResolveCreator
+	case "device":
+		return p.opts.ResolveDevice
+	default:
+		return nil
+	}
+}
+
+// readValue reads a quoted string or a bare word ending at space or ')'.
+func (p *parser) readValue() (string, bool, error) {
+	if p.peek() != '"' {
+		start := p.pos
+		for !p.eof() {
+			r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+			if unicode.IsSpace(r) || r == ')' {
+				break
+			}
+			p.pos += size
+		}
+		return p.src[start:p.pos], false, nil
+	}
+
+	start := p.pos
+	p.pos++
+	var sb strings.Builder
+	for !p.eof() {
+		c := p.src[p.pos]
+		switch {
+		case c == '"':
+			p.pos++
+			return sb.String(), true, nil
+		case c == '\\' && p.pos+1 < len(p.src):
+			sb.WriteByte(p.src[p.pos+1])
+			p.pos += 2
+		default:
+			sb.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", true, p.errorf(start, "unterminated string")
+}
+
+// keyword consumes kw if it is the next whole word.
+func (p *parser) keyword(kw string) bool {
+	p.skipSpace()
+	if !strings.HasPrefix(p.src[p.pos:], kw) {
+		return false
+	}
+	end := p.pos + len(kw)
+	if end < len(p.src) {
+		r, _ := utf8.DecodeRuneInString(p.src[end:])
+		if !unicode.IsSpace(r) && r != '(' {
+			return false
+		}
+	}
+	p.pos = end
+	return true
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += size
+	}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// peekToken returns the next whitespace-delimited token for error messages.
+func (p *parser) peekToken() string {
+	rest := p.src[p.pos:]
+	if i := strings.IndexFunc(rest, unicode.IsSpace); i >= 0 {
+		return rest[:i]
+	}
+	return rest
+}
+
+func (p *parser) errorf(pos int, format string, args ...any) error {
+	return &Error{
+		Column: utf8.RuneCountInString(p.src[:pos]) + 1,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+func isFieldRune(c byte) bool {
+	return c == '_' || c == '.' || c == '-' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c >= utf8.RuneSelf
+}
+
+// literal types an unresolved bare value; quoted values stay strings.
+func literal(raw string, quoted bool) any {
+	if quoted {
+		return raw
+	}
+	if raw == "true" || raw == "false" {
+		return raw == "true"
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return f
+	}
+	return raw
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compileMap(t *testing.T, src string, opts *Options) map[string]any {
+	t.Helper()
+	q, err := Compile(src, opts)
+	require.NoError(t, err)
+	return q.AsMap()
+}
+
+func TestCompile(t *testing.T) {
+	opts := &Options{Now: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), IncludeArchived: true}
+
+	tests := []struct {
+		name     string
+		src      string
+		expected map[string]any
+	}{
+		{
+			name:     "contains",
+			src:      `labels:"night"`,
+			expected: map[string]any{"in": []any{"night", map[string]any{"var": "labels"}}},
+		},
+		{
+			name:     "bare custom value",
+			src:      `custom.weather=rain`,
+			expected: map[string]any{"==": []any{map[string]any{"var": "customFields.weather"}, "rain"}},
+		},
+		{
+			name:     "custom number",
+			src:      `custom.speed >= 2.5`,
+			expected: map[string]any{">=": []any{map[string]any{"var": "customFields.speed"}, 2.5}},
+		},
+		{
+			name:     "relative time",
+			src:      `created>7d`,
+			expected: map[string]any{">": []any{map[string]any{"var": "create_time"}, "2026-03-03T00:00:00Z"}},
+		},
+		{
+			name:     "boolean",
+			src:      `archived=false`,
+			expected: map[string]any{"==": []any{map[string]any{"var": "isArchived"}, false}},
+		},
+		{
+			name:     "quoted value with spaces and escapes",
+			src:      `title:"say \"hi\" now"`,
+			expected: map[string]any{"in": []any{`say "hi" now`, map[string]any{"var": "title"}}},
+		},
+		{
+			name: "and chain is flattened",
+			src:  `labels:"night" AND custom.weather=rain AND archived=false`,
+			expected: map[string]any{"and": []any{
+				map[string]any{"in": []any{"night", map[string]any{"var": "labels"}}},
+				map[string]any{"==": []any{map[string]any{"var": "customFields.weather"}, "rain"}},
+				map[string]any{"==": []any{map[string]any{"var": "isArchived"}, false}},
+			}},
+		},
+		{
+			name: "precedence and grouping",
+			src:  `NOT labels:a OR (device=d1 AND creator!=bob)`,
+			expected: map[string]any{"or": []any{
+				map[string]any{"!": []any{map[string]any{"in": []any{"a", map[string]any{"var": "labels"}}}}},
+				map[string]any{"and": []any{
+					map[string]any{"==": []any{map[string]any{"var": "device"}, "d1"}},
+					map[string]any{"!=": []any{map[string]any{"var": "creator"}, "bob"}},
+				}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, compileMap(t, tt.src, opts))
+		})
+	}
+}
+
+func TestCompile_ResolveCustom(t *testing.T) {
+	opts := &Options{ResolveCustom: func(name, value string) (string, any, error) {
+		if name != "weather" {
+			return "", nil, fmt.Errorf("unknown custom field %q", name)
+		}
+		return "customFields.prop-1", "enum-" + value, nil
+	}, IncludeArchived: true}
+
+	assert.Equal(t,
+		map[string]any{"==": []any{map[string]any{"var": "customFields.prop-1"}, "enum-rain"}},
+		compileMap(t, "custom.weather=rain", opts))
+
+	_, err := Compile("archived=false AND custom.wind=high", opts)
+	var qErr *Error
+	require.True(t, errors.As(err, &qErr))
+	assert.Equal(t, 20, qErr.Column)
+	assert.Contains(t, qErr.Msg, `unknown custom field "wind"`)
+}
+
+func TestCompile_ResolveValues(t *testing.T) {
+	ids := map[string]string{"night": "label-1", "bob": "user-1", "SN-42": "device-1"}
+	lookup := func(kind string) func(string) (string, error) {
+		return func(value string) (string, error) {
+			if id, ok := ids[value]; ok {
+				return id, nil
+			}
+			return "", fmt.Errorf("%s %q not found", kind, value)
+		}
+	}
+	opts := &Options{
+		ResolveLabel:    lookup("label"),
+		ResolveCreator:  lookup("user"),
+		ResolveDevice:   lookup("device"),
+		IncludeArchived: true,
+	}
+
+	assert.Equal(t,
+		map[string]any{"and": []any{
+			map[string]any{"in": []any{"label-1", map[string]any{"var": "labels"}}},
+			map[string]any{"==": []any{map[string]any{"var": "creator"}, "user-1"}},
+			map[string]any{"==": []any{map[string]any{"var": "device"}, "device-1"}},
+			map[string]any{"in": []any{"day", map[string]any{"var": "title"}}},
+		}},
+		compileMap(t, `labels:night AND creator=bob AND device=SN-42 AND title:day`, opts))
+
+	_, err := Compile("labels:night AND creator=alice", opts)
+	var qErr *Error
+	require.True(t, errors.As(err, &qErr))
+	assert.Equal(t, 26, qErr.Column)
+	assert.Contains(t, qErr.Msg, `user "alice" not found`)
+}
+
+func TestCompile_Archived(t *testing.T) {
+	notArchived := map[string]any{"==": []any{map[string]any{"var": "isArchived"}, false}}
+	night := map[string]any{"in": []any{"night", map[string]any{"var": "labels"}}}
+	day := map[string]any{"in": []any{"day", map[string]any{"var": "labels"}}}
+
+	assert.Equal(t,
+		map[string]any{"and": []any{night, notArchived}},
+		compileMap(t, `labels:night`, nil))
+	assert.Equal(t,
+		map[string]any{"and": []any{night, day, notArchived}},
+		compileMap(t, `labels:night AND labels:day`, nil))
+	assert.Equal(t,
+		map[string]any{"and": []any{map[string]any{"or": []any{night, day}}, notArchived}},
+		compileMap(t, `labels:night OR labels:day`, nil))
+	assert.Equal(t,
+		map[string]any{"or": []any{night, map[string]any{"==": []any{map[string]any{"var": "isArchived"}, true}}}},
+		compileMap(t, `labels:night OR archived=true`, nil))
+	assert.Equal(t, night, compileMap(t, `labels:night`, &Options{IncludeArchived: true}))
+}
+
+func TestCompile_Errors(t *testing.T) {
+	tests := []struct {
+		src    string
+		column int
+		msg    string
+	}{
+		{"", 1, "empty query"},
+		{"colour=red", 1, `unknown field "colour"`},
+		{"labels night", 8, "expected an operator"},
+		{"labels:", 8, "expected a value"},
+		{`title:"open`, 7, "unterminated string"},
+		{"(labels:a OR labels:b", 1, "unclosed parenthesis"},
+		{"labels:a labels:b", 10, "expected AND, OR or end of query"},
+		{"labels:a AND", 13, "unexpected end of query"},
+		{"created:2026-01-01", 8, "not supported for created"},
+		{"created>soon", 9, "invalid time"},
+		{"archived=maybe", 10, "invalid boolean"},
+		{"custom.=x", 1, "missing custom field name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			_, err := Compile(tt.src, nil)
+			var qErr *Error
+			require.True(t, errors.As(err, &qErr), "expected *Error, got %v", err)
+			assert.Equal(t, tt.column, qErr.Column)
+			assert.Contains(t, qErr.Msg, tt.msg)
+		})
+	}
+}
+
+func TestIsJSON(t *testing.T) {
+	assert.True(t, IsJSON(`{"==":[{"var":"isArchived"},false]}`))
+	assert.True(t, IsJSON(` {`))
+	assert.False(t, IsJSON(`null`))
+	assert.False(t, IsJSON(`[]`))
+	assert.False(t, IsJSON(`42`))
+	assert.False(t, IsJSON(`labels:"night"`))
+	assert.False(t, IsJSON(`archived=false`))
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package recordspec defines the YAML document accepted by `cocli record apply`.
+package recordspec
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/coscene-io/cocli/internal/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is a declarative description of a set of records.
+type Spec struct {
+	// KeyField is the custom field that, together with the title, identifies
+	// an existing record. If empty, records are matched by title only.
+	KeyField string    `yaml:"keyField"`
+	Records  []*Record `yaml:"records"`
+}
+
+// Record describes the desired state of a single record.
+type Record struct {
+	Title string `yaml:"title"`
+	// Description is left untouched on existing records when omitted.
+	Description *string `yaml:"description"`
+	// Labels replaces the record labels; left untouched when omitted.
+	Labels       []string          `yaml:"labels"`
+	CustomFields map[string]string `yaml:"customFields"`
+	// Device is the id, serial number or resource name of the device that
+	// produced the record. It is only set when the record is created.
+	Device string `yaml:"device"`
+	// Thumbnail is only uploaded when the record is created.
+	Thumbnail string    `yaml:"thumbnail"`
+	Files     []*File   `yaml:"files"`
+	Moments   []*Moment `yaml:"moments"`
+}
+
+// File maps a local file or directory to a directory in the record.
+type File struct {
+	Local     string `yaml:"local"`
+	RemoteDir string `yaml:"remoteDir"`
+}
+
+// Moment describes a moment in the record.
+type Moment struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// TriggerTime is RFC3339, a datetime in the local time zone or unix seconds.
+	TriggerTime string `yaml:"triggerTime"`
+	// Duration is a Go duration ("1.5s"), seconds or a clock ("00:01:30").
+	// Defaults to 1s.
+	Duration   string            `yaml:"duration"`
+	Attributes map[string]string `yaml:"attributes"`
+}
+
+// Load reads, validates and normalizes a spec file. Relative local paths are
+// resolved against the directory containing the spec file.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	baseDir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range spec.Records {
+		if r.Thumbnail != "" && !filepath.IsAbs(r.Thumbnail) {
+			r.Thumbnail = filepath.Join(baseDir, r.Thumbnail)
+		}
+		for _, f := range r.Files {
+			if !filepath.IsAbs(f.Local) {
+				f.Local = filepath.Join(baseDir, f.Local)
+			}
+		}
+	}
+	return spec, nil
+}
+
+// Parse decodes and validates a spec document.
+func Parse(data []byte) (*Spec, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	spec := &Spec{}
+	if err := dec.Decode(spec); err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Validate checks that every record is well-formed and uniquely keyed.
+func (s *Spec) Validate() error {
+	if len(s.Records) == 0 {
+		return fmt.Errorf("no records in spec")
+	}
+
+	seen := make(map[string]int)
+	for i, r := range s.Records {
+		if r == nil || strings.TrimSpace(r.Title) == "" {
+			return fmt.Errorf("records[%d]: title is required", i)
+		}
+		if s.KeyField != "" {
+			if _, ok := r.CustomFields[s.KeyField]; !ok {
+				return fmt.Errorf("records[%d]: key field %q is not set", i, s.KeyField)
+			}
+		}
+		key := r.Key(s.KeyField)
+		if j, ok := seen[key]; ok {
+			return fmt.Errorf("records[%d]: duplicates the key of records[%d]", i, j)
+		}
+		seen[key] = i
+
+		for j, f := range r.Files {
+			if f == nil || f.Local == "" {
+				return fmt.Errorf("records[%d].files[%d]: local is required", i, j)
+			}
+		}
+		for j, m := range r.Moments {
+			if m == nil || m.Name == "" {
+				return fmt.Errorf("records[%d].moments[%d]: name is required", i, j)
+			}
+			if _, err := m.Trigger(); err != nil {
+				return fmt.Errorf("records[%d].moments[%d]: %w", i, j, err)
+			}
+			if _, err := m.DurationValue(); err != nil {
+				return fmt.Errorf("records[%d].moments[%d]: %w", i, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Key returns the identity of the record within a spec.
+func (r *Record) Key(keyField string) string {
+	if keyField == "" {
+		return r.Title
+	}
+	return r.Title + "\x00" + r.CustomFields[keyField]
+}
+
+// CustomFieldArgs returns the custom fields as sorted key=value strings, the
+// format accepted by customfield.Resolver.
+func (r *Record) CustomFieldArgs() []string {
+	args := make([]string, 0, len(r.CustomFields))
+	for k, v := range r.CustomFields {
+		args = append(args, k+"="+v)
+	}
+	sort.Strings(args)
+	return args
+}
+
+// Trigger parses the trigger time of the moment.
+func (m *Moment) Trigger() (time.Time, error) {
+	if m.TriggerTime == "" {
+		return time.Time{}, fmt.Errorf("triggerTime is required")
+	}
+	t, err := utils.ParseTime(m.TriggerTime, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid triggerTime: %w", err)
+	}
+	return t, nil
+}
+
+// DurationValue parses the duration of the moment.
+func (m *Moment) DurationValue() (time.Duration, error) {
+	if m.Duration == "" {
+		return time.Second, nil
+	}
+	d, err := utils.ParseTimeSpan(m.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration: %w", err)
+	}
+	return d, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recordspec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleSpec = `
+keyField: run_id
+records:
+  - title: drive-001
+    description: highway run
+    labels: [highway, night]
+    customFields:
+      run_id: "001"
+      weather: rain
+    device: R12
+    thumbnail: thumb.png
+    files:
+      - local: data/drive-001
+        remoteDir: raw/
+    moments:
+      - name: hard brake
+        triggerTime: "2026-01-02T03:04:05Z"
+        duration: 2.5
+      - name: lane change
+        triggerTime: "1767323045.5"
+        duration: 500ms
+        attributes:
+          side: left
+`
+
+func TestParse(t *testing.T) {
+	spec, err := Parse([]byte(sampleSpec))
+	require.NoError(t, err)
+	require.Len(t, spec.Records, 1)
+
+	r := spec.Records[0]
+	assert.Equal(t, "run_id", spec.KeyField)
+	require.NotNil(t, r.Description)
+	assert.Equal(t, "highway run", *r.Description)
+	assert.Equal(t, []string{"highway", "night"}, r.Labels)
+	assert.Equal(t, "R12", r.Device)
+	assert.Equal(t, []string{"run_id=001", "weather=rain"}, r.CustomFieldArgs())
+	assert.Equal(t, "drive-001\x00001", r.Key(spec.KeyField))
+
+	trigger, err := r.Moments[0].Trigger()
+	require.NoError(t, err)
+	assert.True(t, trigger.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)))
+	d, err := r.Moments[0].DurationValue()
+	require.NoError(t, err)
+	assert.Equal(t, 2500*time.Millisecond, d)
+
+	trigger, err = r.Moments[1].Trigger()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1767323045500), trigger.UnixMilli())
+	d, err = r.Moments[1].DurationValue()
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, d)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no records":     `records: []`,
+		"missing title":  "records:\n  - description: x",
+		"unknown field":  "records:\n  - title: a\n    colour: red",
+		"missing key":    "keyField: run_id\nrecords:\n  - title: a",
+		"duplicate keys": "records:\n  - title: a\n  - title: a",
+		"missing local":  "records:\n  - title: a\n    files:\n      - remoteDir: x/",
+		"bad trigger":    "records:\n  - title: a\n    moments:\n      - name: m\n        triggerTime: yesterday",
+		"bad duration":   "records:\n  - title: a\n    moments:\n      - name: m\n        triggerTime: \"1\"\n        duration: forever",
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(doc))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParse_SameTitleDifferentKey(t *testing.T) {
+	doc := "keyField: run_id\nrecords:\n  - title: a\n    customFields: {run_id: \"1\"}\n  - title: a\n    customFields: {run_id: \"2\"}"
+	_, err := Parse([]byte(doc))
+	assert.NoError(t, err)
+}
+
+func TestLoad_ResolvesRelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "records.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(sampleSpec), 0644))
+
+	spec, err := Load(path)
+	require.NoError(t, err)
+
+	r := spec.Records[0]
+	assert.Equal(t, filepath.Join(dir, "thumb.png"), r.Thumbnail)
+	assert.Equal(t, filepath.Join(dir, "data/drive-001"), r.Files[0].Local)
+	assert.Equal(t, "raw/", r.Files[0].RemoteDir)
+}
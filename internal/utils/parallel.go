@@ -0,0 +1,45 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "sync"
+
+// ParallelFor calls fn for every item using at most parallel goroutines and
+// returns the per-item errors in input order.
+func ParallelFor[T any](items []T, parallel int, fn func(idx int, item T) error) []error {
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	var (
+		errs = make([]error, len(items))
+		sem  = make(chan struct{}, parallel)
+		wg   sync.WaitGroup
+	)
+	for i, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = fn(i, item)
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
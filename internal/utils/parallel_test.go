@@ -0,0 +1,61 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelFor(t *testing.T) {
+	var (
+		running atomic.Int32
+		peak    atomic.Int32
+	)
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	errs := ParallelFor(items, 3, func(_ int, item int) error {
+		cur := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+		if item%2 == 1 {
+			return fmt.Errorf("odd %d", item)
+		}
+		return nil
+	})
+
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+	assert.Len(t, errs, len(items))
+	for i, err := range errs {
+		if i%2 == 1 {
+			assert.EqualError(t, err, fmt.Sprintf("odd %d", i))
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestParallelFor_Empty(t *testing.T) {
+	errs := ParallelFor([]string{}, 0, func(int, string) error { return nil })
+	assert.Empty(t, errs)
+}
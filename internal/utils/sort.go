@@ -0,0 +1,82 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Sort field names accepted by --sort-by, in AIP-132 order_by spelling.
+const (
+	SortCreateTime = "create_time"
+	SortUpdateTime = "update_time"
+	SortTitle      = "title"
+	SortSize       = "size"
+)
+
+// SortOrder is a validated --sort-by field and --desc direction. The zero
+// value keeps the API's default order.
+type SortOrder struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSortOrder validates field against the fields a listing supports. An
+// empty field yields the zero order, in which case desc is rejected.
+func ParseSortOrder(field string, desc bool, supported []string) (SortOrder, error) {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		if desc {
+			return SortOrder{}, fmt.Errorf("--desc requires --sort-by")
+		}
+		return SortOrder{}, nil
+	}
+	if !slices.Contains(supported, field) {
+		return SortOrder{}, fmt.Errorf("unsupported --sort-by %q, must be one of: %s", field, strings.Join(supported, ", "))
+	}
+	return SortOrder{Field: field, Desc: desc}, nil
+}
+
+// IsZero reports whether no order was requested.
+func (o SortOrder) IsZero() bool {
+	return o.Field == ""
+}
+
+// OrderBy renders the order as an AIP-132 order_by string, e.g. "create_time desc".
+func (o SortOrder) OrderBy() string {
+	if o.Desc && o.Field != "" {
+		return o.Field + " desc"
+	}
+	return o.Field
+}
+
+// SortBy stably sorts items client side, for listings whose API has no
+// order_by. compare maps each supported field to an ascending comparison.
+// Sorting the complete result, rather than each page, keeps the order
+// correct across pages.
+func SortBy[T any](items []T, o SortOrder, compare map[string]func(a, b T) int) {
+	cmp, ok := compare[o.Field]
+	if !ok {
+		return
+	}
+	slices.SortStableFunc(items, func(a, b T) int {
+		if o.Desc {
+			return cmp(b, a)
+		}
+		return cmp(a, b)
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSortOrder(t *testing.T) {
+	supported := []string{SortCreateTime, SortTitle}
+
+	o, err := ParseSortOrder("", false, supported)
+	require.NoError(t, err)
+	assert.True(t, o.IsZero())
+	assert.Equal(t, "", o.OrderBy())
+
+	o, err = ParseSortOrder("create_time", true, supported)
+	require.NoError(t, err)
+	assert.Equal(t, "create_time desc", o.OrderBy())
+
+	o, err = ParseSortOrder("title", false, supported)
+	require.NoError(t, err)
+	assert.Equal(t, "title", o.OrderBy())
+
+	_, err = ParseSortOrder("size", false, supported)
+	assert.EqualError(t, err, `unsupported --sort-by "size", must be one of: create_time, title`)
+
+	_, err = ParseSortOrder("", true, supported)
+	assert.EqualError(t, err, "--desc requires --sort-by")
+}
+
+func TestSortBy(t *testing.T) {
+	type item struct {
+		title string
+		size  int
+	}
+	compare := map[string]func(a, b item) int{
+		SortTitle: func(a, b item) int { return cmp.Compare(a.title, b.title) },
+		"size":    func(a, b item) int { return cmp.Compare(a.size, b.size) },
+	}
+	items := []item{{"b", 2}, {"a", 2}, {"c", 1}}
+
+	SortBy(items, SortOrder{Field: SortTitle}, compare)
+	assert.Equal(t, []item{{"a", 2}, {"b", 2}, {"c", 1}}, items)
+
+	// Stable: equal sizes keep their previous relative order.
+	SortBy(items, SortOrder{Field: "size", Desc: true}, compare)
+	assert.Equal(t, []item{{"a", 2}, {"b", 2}, {"c", 1}}, items)
+
+	SortBy(items, SortOrder{}, compare)
+	assert.Equal(t, []item{{"a", 2}, {"b", 2}, {"c", 1}}, items)
+}
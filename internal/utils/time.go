@@ -0,0 +1,149 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayouts are the absolute time formats accepted on the command line,
+// interpreted in the given time zone when they carry no offset. Fractional
+// seconds are accepted after the seconds field.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// ParseTime parses an absolute time: RFC3339, datetime, date or unix seconds
+// such as "1735700000.5". Times without an offset are taken in loc.
+func ParseTime(s string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	if secs, err := strconv.ParseFloat(s, 64); err == nil && !math.IsInf(secs, 0) && !math.IsNaN(secs) {
+		whole, frac := math.Modf(secs)
+		return time.Unix(int64(whole), int64(math.Round(frac*1e9))), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: expected RFC3339 (e.g. 2025-01-01T00:00:00Z), datetime (e.g. 2025-01-01T10:30), date (e.g. 2025-01-01) or unix seconds", s)
+}
+
+// ParseTimeOrAgo parses an absolute time as ParseTime does in the local time
+// zone, or a duration relative to now ("7d", "36h"), taken as that long ago.
+func ParseTimeOrAgo(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := ParseTime(s, time.Local); err == nil {
+		return t, nil
+	}
+	if d, err := ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: expected RFC3339 (e.g. 2025-01-01T00:00:00Z), datetime (e.g. 2025-01-01T10:30), date (e.g. 2025-01-01), unix seconds or a duration ago (e.g. 7d)", s)
+}
+
+// ParseTimeSpan parses a non-negative span of time given as seconds ("90.5"),
+// a duration ("1m30s", "2d") or a clock ("00:01:30.5", "01:30").
+func ParseTimeSpan(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if secs, err := strconv.ParseFloat(s, 64); err == nil {
+		if secs < 0 || math.IsInf(secs, 0) || math.IsNaN(secs) {
+			return 0, fmt.Errorf("invalid time span %q", s)
+		}
+		return time.Duration(math.Round(secs * float64(time.Second))), nil
+	}
+	if strings.Contains(s, ":") {
+		return parseClock(s)
+	}
+	d, err := ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid time span %q: expected seconds (e.g. 90.5), a duration (e.g. 1m30s) or a clock (e.g. 00:01:30.5)", s)
+	}
+	return d, nil
+}
+
+// parseClock parses "[hh:]mm:ss[.fff]".
+func parseClock(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("invalid clock %q: expected [hh:]mm:ss[.fff]", s)
+	}
+	secs, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil || secs < 0 || secs >= 60 || strings.HasPrefix(parts[len(parts)-1], "+") {
+		return 0, fmt.Errorf("invalid clock %q: expected [hh:]mm:ss[.fff]", s)
+	}
+	total := time.Duration(math.Round(secs * float64(time.Second)))
+	for i, unit := range []time.Duration{time.Minute, time.Hour} {
+		idx := len(parts) - 2 - i
+		if idx < 0 {
+			break
+		}
+		n, err := strconv.ParseUint(parts[idx], 10, 32)
+		if err != nil || (unit == time.Minute && idx > 0 && n >= 60) {
+			return 0, fmt.Errorf("invalid clock %q: expected [hh:]mm:ss[.fff]", s)
+		}
+		total += time.Duration(n) * unit
+	}
+	return total, nil
+}
+
+// TimeParser parses moment times: absolute times as ParseTime does, and
+// offsets from a start time such as "+00:12:03.5", "+1m30s" or "-2.5".
+type TimeParser struct {
+	// Location is used for times without an offset, time.Local if nil.
+	Location *time.Location
+	// Start returns the time offsets are relative to. It is only called for
+	// offsets, which are rejected when it is nil.
+	Start func() (time.Time, error)
+}
+
+// Parse parses an absolute time or an offset from the start.
+func (p TimeParser) Parse(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("time is required")
+	}
+	if s[0] != '+' && s[0] != '-' {
+		loc := p.Location
+		if loc == nil {
+			loc = time.Local
+		}
+		return ParseTime(s, loc)
+	}
+
+	offset, err := ParseTimeSpan(s[1:])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid offset %q: %w", s, err)
+	}
+	if s[0] == '-' {
+		offset = -offset
+	}
+	if p.Start == nil {
+		return time.Time{}, fmt.Errorf("offset %q needs a start time to be relative to", s)
+	}
+	start, err := p.Start()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to get the start time for offset %q: %w", s, err)
+	}
+	return start.Add(offset), nil
+}
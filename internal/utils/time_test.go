@@ -0,0 +1,143 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeOrAgo(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"2025-01-01T00:00:00Z", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2025-01-01T10:30", time.Date(2025, 1, 1, 10, 30, 0, 0, time.Local)},
+		{"2025-01-01", time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local)},
+		{"7d", now.Add(-7 * 24 * time.Hour)},
+		{"36h", now.Add(-36 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTimeOrAgo(tt.input, now)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got), "expected %s, got %s", tt.expected, got)
+		})
+	}
+}
+
+func TestParseTimeOrAgo_Invalid(t *testing.T) {
+	for _, input := range []string{"", "yesterday", "2025-13-01"} {
+		_, err := ParseTimeOrAgo(input, time.Now())
+		assert.Error(t, err, "input %q", input)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"2025-01-01T00:00:00.5Z", time.Date(2025, 1, 1, 0, 0, 0, 5e8, time.UTC)},
+		{"2025-01-01T10:30:00+02:00", time.Date(2025, 1, 1, 8, 30, 0, 0, time.UTC)},
+		{"2025-01-01T10:30:15.25", time.Date(2025, 1, 1, 10, 30, 15, 25e7, shanghai)},
+		{"2025-01-01 10:30:15", time.Date(2025, 1, 1, 10, 30, 15, 0, shanghai)},
+		{"2025-01-01", time.Date(2025, 1, 1, 0, 0, 0, 0, shanghai)},
+		{"1735700000.5", time.Unix(1735700000, 5e8)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTime(tt.input, shanghai)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got), "expected %s, got %s", tt.expected, got)
+		})
+	}
+
+	for _, input := range []string{"", "yesterday", "7d", "NaN"} {
+		_, err := ParseTime(input, time.UTC)
+		assert.Error(t, err, "input %q", input)
+	}
+}
+
+func TestParseTimeSpan(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{"1.5", 1500 * time.Millisecond},
+		{"0", 0},
+		{"1m30s", 90 * time.Second},
+		{"2d", 48 * time.Hour},
+		{"00:12:03.5", 12*time.Minute + 3500*time.Millisecond},
+		{"12:03", 12*time.Minute + 3*time.Second},
+		{"100:00:00", 100 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTimeSpan(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	for _, input := range []string{"", "-1", "-1s", "1:60", "1:61:00", "1:2:3:4", "a:00", "forever"} {
+		_, err := ParseTimeSpan(input)
+		assert.Error(t, err, "input %q", input)
+	}
+}
+
+func TestTimeParser(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	calls := 0
+	p := TimeParser{
+		Location: time.UTC,
+		Start: func() (time.Time, error) {
+			calls++
+			return start, nil
+		},
+	}
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"+00:12:03.5", start.Add(12*time.Minute + 3500*time.Millisecond)},
+		{"+1m30s", start.Add(90 * time.Second)},
+		{"+2.5", start.Add(2500 * time.Millisecond)},
+		{"-5s", start.Add(-5 * time.Second)},
+		{"2025-01-01T12:00:00", time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := p.Parse(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got), "expected %s, got %s", tt.expected, got)
+		})
+	}
+	assert.Equal(t, 4, calls, "start is only needed for offsets")
+
+	_, err := TimeParser{}.Parse("+10s")
+	assert.ErrorContains(t, err, "needs a start time")
+	_, err = p.Parse("+soon")
+	assert.ErrorContains(t, err, "invalid offset")
+	_, err = p.Parse("")
+	assert.Error(t, err)
+}
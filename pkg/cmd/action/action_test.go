@@ -69,6 +69,8 @@ func TestActionCommand(t *testing.T) {
 
 		assert.NotNil(t, listCmd.Flag("project"), "Flag --project not found")
 		assert.NotNil(t, listCmd.Flag("output"), "Flag --output not found")
+		assert.NotNil(t, listCmd.Flag("sort-by"), "Flag --sort-by not found")
+		assert.NotNil(t, listCmd.Flag("desc"), "Flag --desc not found")
 	})
 
 	t.Run("Create command flags", func(t *testing.T) {
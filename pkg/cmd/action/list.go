@@ -16,6 +16,7 @@ package action
 
 import (
 	"context"
+	"strings"
 
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	"github.com/coscene-io/cocli/api"
@@ -25,6 +26,7 @@ import (
 	"github.com/coscene-io/cocli/internal/printer"
 	"github.com/coscene-io/cocli/internal/printer/printable"
 	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/internal/utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
 	mapset "github.com/deckarep/golang-set/v2"
 	log "github.com/sirupsen/logrus"
@@ -36,14 +38,21 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 		projectSlug  = ""
 		verbose      = false
 		outputFormat = ""
+		sortBy       = ""
+		desc         = false
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "list [-v] [-p <working-project-slug>]",
+		Use:                   "list [-v] [-p <working-project-slug>] [--sort-by <field> [--desc]]",
 		Short:                 "List actions in the current project",
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.ExactArgs(0),
 		Run: func(cmd *cobra.Command, args []string) {
+			order, err := utils.ParseSortOrder(sortBy, desc, actionSortFields)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
 			// Get current profile.
 			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
 			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
@@ -70,6 +79,7 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 			}
 
 			allActions := append(actions, systemActions...)
+			utils.SortBy(allActions, order, actionCompare)
 
 			// Convert users to nicknames.
 			convertActionUsers(cmd.Context(), allActions, pm)
@@ -90,10 +100,26 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
 	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
 	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table|json|yaml)")
+	cmd.Flags().StringVar(&sortBy, "sort-by", "", "sort actions by field ("+strings.Join(actionSortFields, "|")+")")
+	cmd.Flags().BoolVar(&desc, "desc", false, "sort in descending order, requires --sort-by")
 
 	return cmd
 }
 
+var actionSortFields = []string{utils.SortCreateTime, utils.SortUpdateTime, utils.SortTitle}
+
+var actionCompare = map[string]func(a, b *openv1alpha1resource.Action) int{
+	utils.SortCreateTime: func(a, b *openv1alpha1resource.Action) int {
+		return a.GetCreateTime().AsTime().Compare(b.GetCreateTime().AsTime())
+	},
+	utils.SortUpdateTime: func(a, b *openv1alpha1resource.Action) int {
+		return a.GetUpdateTime().AsTime().Compare(b.GetUpdateTime().AsTime())
+	},
+	utils.SortTitle: func(a, b *openv1alpha1resource.Action) int {
+		return strings.Compare(a.GetSpec().GetName(), b.GetSpec().GetName())
+	},
+}
+
 func convertActionUsers(ctx context.Context, actions []*openv1alpha1resource.Action, pm *config.ProfileManager) {
 	// Search for all users in actions authors.
 	usersSet := mapset.NewSet[name.User]()
@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -24,7 +25,9 @@ import (
 	"github.com/coscene-io/cocli/internal/iostreams"
 	"github.com/coscene-io/cocli/internal/name"
 	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/internal/query"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/samber/lo"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -39,10 +42,12 @@ func NewRunCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(st
 		force        = false
 		projectSlug  = ""
 		recordSearch = ""
+		explain      = false
+		filterFlags  = &cmd_utils.RecordFilterFlags{}
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "run <action-resource-name/id> [record-resource-name/id] [--search <json-logic>] [-p <working-project-slug>] [-P <key1=value1>...] [--skip-params] [-f]",
+		Use:                   "run <action-resource-name/id> [record-resource-name/id] [--search <query>] [<filter flags>] [--explain] [-p <working-project-slug>] [-P <key1=value1>...] [--skip-params] [-f]",
 		Short:                 "Create an action run.",
 		Args:                  validateRunArgs,
 		DisableFlagsInUseLine: true,
@@ -64,9 +69,25 @@ func NewRunCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(st
 			if len(args) == 2 {
 				recordName = recordNameFromArg(args[1], proj)
 			} else {
-				recordQuery, err = parseRecordSearch(recordSearch)
+				var searchQuery *structpb.Struct
+				if recordSearch != "" {
+					searchQuery, err = compileRecordSearch(cmd.Context(), pm, proj, recordSearch)
+					if err != nil {
+						log.Fatalf("failed to parse record search: %v", err)
+					}
+				}
+				filterQuery, err := filterFlags.Query(cmd.Context(), pm, proj)
 				if err != nil {
-					log.Fatalf("failed to parse record search: %v", err)
+					log.Fatalf("unable to build record filter: %v", err)
+				}
+				recordQuery = cmd_utils.AndQuery(searchQuery, filterQuery)
+				if explain {
+					explained, err := cmd_utils.ExplainSearch(recordQuery)
+					if err != nil {
+						log.Fatalf("unable to render search: %v", err)
+					}
+					io.Println(explained)
+					return
 				}
 			}
 			act, err := pm.ActionCli().GetByName(cmd.Context(), actionName)
@@ -120,7 +141,11 @@ func NewRunCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(st
 	cmd.Flags().BoolVar(&skipParams, "skip-params", false, "skip parameter input and use default values")
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "force create action run without confirmation")
 	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
-	cmd.Flags().StringVarP(&recordSearch, "search", "s", "", "JSON Logic search query selecting records for the action run")
+	// The filter flags are registered before --search, as action runs AND them
+	// into the JSON Logic query instead of treating them as an alternative.
+	filterFlags.Register(cmd)
+	cmd.Flags().StringVarP(&recordSearch, "search", "s", "", `search query selecting records for the action run, e.g. 'labels:"night"', JSON Logic or @<saved-search>`)
+	cmd.Flags().BoolVar(&explain, "explain", false, "print the JSON Logic generated for --search and the filter flags instead of creating the action run")
 
 	cmd.MarkFlagsMutuallyExclusive("skip-params", "param")
 
@@ -143,22 +168,38 @@ func validateRunArgs(cmd *cobra.Command, args []string) error {
 	if searchSet && strings.TrimSpace(recordSearch) == "" {
 		return fmt.Errorf("search query must not be empty")
 	}
-	if len(args) == 1 && !searchSet {
-		return fmt.Errorf("requires a record argument or --search")
+	filterSet := lo.ContainsBy(cmd_utils.RecordFilterFlagNames, func(flag string) bool {
+		return cmd.Flags().Changed(flag)
+	})
+	if len(args) == 1 && !searchSet && !filterSet {
+		return fmt.Errorf("requires a record argument or --search or filter flags")
 	}
-	if len(args) == 2 && searchSet {
-		return fmt.Errorf("record argument and --search are mutually exclusive")
+	if len(args) == 2 && (searchSet || filterSet) {
+		return fmt.Errorf("record argument and --search or filter flags are mutually exclusive")
 	}
-	if searchSet {
+	if _, isRef := config.ParseSavedSearchRef(recordSearch); searchSet && !isRef {
 		if _, err := parseRecordSearch(recordSearch); err != nil {
 			return err
 		}
 	}
+	if explain, _ := cmd.Flags().GetBool("explain"); explain && !searchSet && !filterSet {
+		return fmt.Errorf("--explain requires --search or filter flags")
+	}
 
 	return nil
 }
 
+// parseRecordSearch validates a search offline. Queries are only checked for
+// syntax here, as resolving custom fields needs the project schema.
 func parseRecordSearch(search string) (*structpb.Struct, error) {
+	if !query.IsJSON(search) {
+		recordQuery, err := query.Compile(search, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid search query: %w", err)
+		}
+		return recordQuery, nil
+	}
+
 	recordQuery := &structpb.Struct{}
 	if err := protojson.Unmarshal([]byte(search), recordQuery); err != nil {
 		return nil, fmt.Errorf("invalid search JSON: %w", err)
@@ -169,6 +210,29 @@ func parseRecordSearch(search string) (*structpb.Struct, error) {
 	return recordQuery, nil
 }
 
+// compileRecordSearch compiles --search, resolving saved search references.
+// Action runs take a JSON Logic query, so the selection flags of a saved
+// search are ANDed into it.
+func compileRecordSearch(ctx context.Context, pm *config.ProfileManager, proj *name.Project, search string) (*structpb.Struct, error) {
+	ref, ok := config.ParseSavedSearchRef(search)
+	if !ok {
+		return cmd_utils.CompileSearch(ctx, pm, proj, search, false)
+	}
+
+	saved, err := pm.GetCurrentProfile().GetSavedSearch(ref)
+	if err != nil {
+		return nil, err
+	}
+	q, err := cmd_utils.SavedSearchQuery(ctx, pm, proj, saved)
+	if err != nil {
+		return nil, err
+	}
+	if q == nil {
+		return nil, fmt.Errorf("saved search @%s selects every record, which an action run does not accept", ref)
+	}
+	return q, nil
+}
+
 func recordNameFromArg(recordIDOrName string, project *name.Project) *name.Record {
 	recordName, err := name.NewRecord(recordIDOrName)
 	if err == nil {
@@ -20,6 +20,7 @@ func TestNewRunCommandValidatesArgs(t *testing.T) {
 		args         []string
 		recordSearch string
 		setSearch    bool
+		flags        map[string]string
 		wantErr      string
 	}{
 		{name: "no arguments", wantErr: "requires an action argument"},
@@ -31,9 +32,15 @@ func TestNewRunCommandValidatesArgs(t *testing.T) {
 		{name: "empty search with record", args: []string{"action", "record"}, setSearch: true, wantErr: "search query must not be empty"},
 		{name: "invalid search JSON", args: []string{"action"}, recordSearch: `{`, setSearch: true, wantErr: "invalid search JSON"},
 		{name: "empty search object", args: []string{"action"}, recordSearch: `{}`, setSearch: true, wantErr: "search query must not be empty"},
-		{name: "null search", args: []string{"action"}, recordSearch: `null`, setSearch: true, wantErr: "invalid search JSON"},
-		{name: "search array", args: []string{"action"}, recordSearch: `[]`, setSearch: true, wantErr: "invalid search JSON"},
+		{name: "null search", args: []string{"action"}, recordSearch: `null`, setSearch: true, wantErr: "invalid search query"},
+		{name: "search array", args: []string{"action"}, recordSearch: `[]`, setSearch: true, wantErr: "invalid search query"},
 		{name: "too many arguments", args: []string{"action", "record", "extra"}, wantErr: "accepts at most 2 arguments"},
+		{name: "search query", args: []string{"action"}, recordSearch: `labels:"night" AND created>2026-01-01`, setSearch: true},
+		{name: "invalid search query", args: []string{"action"}, recordSearch: `labels night`, setSearch: true, wantErr: "invalid search query: column 8"},
+		{name: "saved search", args: []string{"action"}, recordSearch: "@nightly", setSearch: true},
+		{name: "filter flags", args: []string{"action"}, flags: map[string]string{"created-after": "7d", "device": "dev-1"}},
+		{name: "search and filter flags", args: []string{"action"}, recordSearch: `labels:"night"`, setSearch: true, flags: map[string]string{"creator": "bob"}},
+		{name: "record and filter flags", args: []string{"action", "record"}, flags: map[string]string{"created-after": "7d"}, wantErr: "mutually exclusive"},
 	}
 
 	for _, tt := range tests {
@@ -42,6 +49,9 @@ func TestNewRunCommandValidatesArgs(t *testing.T) {
 			if tt.setSearch {
 				require.NoError(t, cmd.Flags().Set("search", tt.recordSearch))
 			}
+			for flag, value := range tt.flags {
+				require.NoError(t, cmd.Flags().Set(flag, value))
+			}
 			err := cmd.Args(cmd, tt.args)
 			if tt.wantErr != "" {
 				assert.ErrorContains(t, err, tt.wantErr)
@@ -0,0 +1,62 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewDescribeCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		outputFormat = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "describe <device-resource-name/id/serial-number> [-o <output-format>]",
+		Short:                 "Show the details of a device",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+
+			deviceName, err := pm.DeviceCli().ResolveName(cmd.Context(), args[0])
+			if err != nil {
+				log.Fatalf("unable to resolve device: %v", err)
+			}
+			device, err := pm.DeviceCli().Get(cmd.Context(), deviceName)
+			if err != nil {
+				log.Fatalf("unable to get device: %v", err)
+			}
+
+			p, err := printer.Printer(outputFormat, &printer.Options{})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err = p.PrintObj(printable.NewSingleDevice(device), io.Out); err != nil {
+				log.Fatalf("unable to print device: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|json|yaml)")
+
+	return cmd
+}
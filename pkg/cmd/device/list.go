@@ -0,0 +1,78 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"sort"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		verbose      = false
+		outputFormat = ""
+		projectSlug  = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "list [-v] [-p <project-slug>] [-o <output-format>]",
+		Short:                 "List devices in the organization or a project",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+
+			opts := &api.ListDevicesOptions{}
+			if projectSlug != "" {
+				proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+				if err != nil {
+					log.Fatalf("unable to get project name: %v", err)
+				}
+				opts.Project = proj
+			}
+
+			devices, err := pm.DeviceCli().ListAll(cmd.Context(), opts)
+			if err != nil {
+				log.Fatalf("unable to list devices: %v", err)
+			}
+			sort.SliceStable(devices, func(i, j int) bool { return devices[i].SerialNumber < devices[j].SerialNumber })
+
+			p, err := printer.Printer(outputFormat, &printer.Options{TableOpts: &table.PrintOpts{
+				Verbose: verbose,
+			}})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err = p.PrintObj(printable.NewDevice(devices), io.Out); err != nil {
+				log.Fatalf("unable to print devices: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|json|yaml|csv)")
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "only list the devices of this project (omit for organization devices)")
+
+	return cmd
+}
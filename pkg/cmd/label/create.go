@@ -0,0 +1,65 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "create <display-name>... [-p <working-project-slug>]",
+		Short:                 "Create labels in a project",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			for _, displayName := range args {
+				existing, err := pm.LabelCli().GetByDisplayName(cmd.Context(), displayName, proj)
+				if err == nil {
+					io.Printf("Label %q already exists: %s\n", displayName, existing.Name)
+					continue
+				} else if !utils.IsConnectErrorWithCode(err, connect.CodeNotFound) {
+					log.Fatalf("unable to get label %s: %v", displayName, err)
+				}
+
+				label, err := pm.LabelCli().Create(cmd.Context(), displayName, proj)
+				if err != nil {
+					log.Fatalf("unable to create label: %v", err)
+				}
+				io.Printf("Label %q created: %s\n", displayName, label.Name)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+
+	return cmd
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"fmt"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewDeleteCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		force       = false
+		projectSlug = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "delete <display-name> [-p <working-project-slug>] [-f]",
+		Short:                 "Delete a label",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			label, err := pm.LabelCli().GetByDisplayName(cmd.Context(), args[0], proj)
+			if err != nil {
+				log.Fatalf("unable to get label %s: %v", args[0], err)
+			}
+
+			// Confirm deletion.
+			if !force {
+				records, err := pm.RecordCli().SearchAll(cmd.Context(), &api.SearchRecordsOptions{
+					Project:        proj,
+					Labels:         []string{args[0]},
+					IncludeArchive: true,
+				})
+				if err != nil {
+					log.Fatalf("unable to search records: %v", err)
+				}
+				msg := fmt.Sprintf("Label %q is on %d record(s). Are you sure you want to delete it?", args[0], len(records))
+				if confirmed := prompts.PromptYN(msg, io); !confirmed {
+					io.Println("Delete label aborted.")
+					return
+				}
+			}
+
+			if err = pm.LabelCli().Delete(cmd.Context(), label.Name); err != nil {
+				log.Fatalf("failed to delete label: %v", err)
+			}
+
+			io.Printf("Label successfully deleted.\n")
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", force, "Force delete without confirmation")
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+
+	return cmd
+}
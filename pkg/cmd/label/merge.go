@@ -0,0 +1,119 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"fmt"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewMergeCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		force       = false
+		keepSource  = false
+		projectSlug = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "merge <source-display-name> <target-display-name> [-p <working-project-slug>] [--keep-source] [-f]",
+		Short:                 "Move every record from one label onto another",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			source, err := pm.LabelCli().GetByDisplayName(cmd.Context(), args[0], proj)
+			if err != nil {
+				log.Fatalf("unable to get label %s: %v", args[0], err)
+			}
+			target, err := pm.LabelCli().GetByDisplayName(cmd.Context(), args[1], proj)
+			if err != nil {
+				log.Fatalf("unable to get label %s: %v", args[1], err)
+			}
+			if source.Name == target.Name {
+				log.Fatalf("source and target are the same label")
+			}
+
+			records, err := pm.RecordCli().SearchAll(cmd.Context(), &api.SearchRecordsOptions{
+				Project:        proj,
+				Labels:         []string{args[0]},
+				IncludeArchive: true,
+			})
+			if err != nil {
+				log.Fatalf("unable to search records: %v", err)
+			}
+
+			if !force {
+				msg := fmt.Sprintf("Relabel %d record(s) from %q to %q?", len(records), args[0], args[1])
+				if confirmed := prompts.PromptYN(msg, io); !confirmed {
+					io.Println("Merge labels aborted.")
+					return
+				}
+			}
+
+			failed := 0
+			for _, r := range records {
+				recordName, err := name.NewRecord(r.Name)
+				if err != nil {
+					log.Fatalf("unable to parse record name %s: %v", r.Name, err)
+				}
+				if err = pm.RecordCli().Update(cmd.Context(), recordName, "", "", mergeLabels(r.Labels, source, target), nil, []string{"labels"}); err != nil {
+					failed++
+					log.Errorf("failed to relabel record %s: %v", recordName, err)
+				}
+			}
+			if failed > 0 {
+				log.Fatalf("failed to relabel %d of %d record(s), label %q was kept", failed, len(records), args[0])
+			}
+			io.Printf("Relabeled %d record(s).\n", len(records))
+
+			if keepSource {
+				return
+			}
+			if err = pm.LabelCli().Delete(cmd.Context(), source.Name); err != nil {
+				log.Fatalf("failed to delete label %s: %v", args[0], err)
+			}
+			io.Printf("Label %q deleted.\n", args[0])
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", force, "merge without confirmation")
+	cmd.Flags().BoolVar(&keepSource, "keep-source", false, "keep the source label after relabeling")
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+
+	return cmd
+}
+
+// mergeLabels replaces source with target in labels, without duplicating target.
+func mergeLabels(labels []*openv1alpha1resource.Label, source, target *openv1alpha1resource.Label) []*openv1alpha1resource.Label {
+	ret := lo.Filter(labels, func(l *openv1alpha1resource.Label, _ int) bool {
+		return l.Name != source.Name && l.Name != target.Name
+	})
+	return append(ret, target)
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import (
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewRenameCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "rename <display-name> <new-display-name> [-p <working-project-slug>]",
+		Short:                 "Rename a label",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			label, err := pm.LabelCli().GetByDisplayName(cmd.Context(), args[0], proj)
+			if err != nil {
+				log.Fatalf("unable to get label %s: %v", args[0], err)
+			}
+
+			// Two labels with the same display name would be indistinguishable.
+			if _, err = pm.LabelCli().GetByDisplayName(cmd.Context(), args[1], proj); err == nil {
+				log.Fatalf("label %q already exists, use `cocli label merge` to combine the labels", args[1])
+			} else if !utils.IsConnectErrorWithCode(err, connect.CodeNotFound) {
+				log.Fatalf("unable to get label %s: %v", args[1], err)
+			}
+
+			if _, err = pm.LabelCli().Rename(cmd.Context(), label.Name, args[1]); err != nil {
+				log.Fatalf("unable to rename label: %v", err)
+			}
+			io.Printf("Label %q renamed to %q.\n", args[0], args[1])
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+
+	return cmd
+}
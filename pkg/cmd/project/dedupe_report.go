@@ -0,0 +1,121 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"strings"
+	"sync"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	printutils "github.com/coscene-io/cocli/internal/printer/utils"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewDedupeReportCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug    = ""
+		includeArchive = false
+		filterFlags    = &cmd_utils.RecordFilterFlags{}
+		parallel       = 0
+		verbose        = false
+		outputFormat   = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "dedupe-report [-p <working-project-slug>] [--include-archive] [<filter flags>] [-v] [-o <output-format>]",
+		Short:                 "Report files whose content is stored in several places of a project",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			searchOptions := &api.SearchRecordsOptions{
+				Project:        proj,
+				IncludeArchive: includeArchive,
+			}
+			if err = filterFlags.Apply(cmd.Context(), pm, proj, searchOptions); err != nil {
+				log.Fatalf("unable to build record filter: %v", err)
+			}
+			records, err := pm.RecordCli().SearchAll(cmd.Context(), searchOptions)
+			if err != nil {
+				log.Fatalf("unable to search records: %v", err)
+			}
+
+			var (
+				mu    sync.Mutex
+				files []filestats.File
+			)
+			errs := utils.ParallelFor(records, parallel, func(_ int, r *openv1alpha1resource.Record) error {
+				recordName, err := name.NewRecord(r.Name)
+				if err != nil {
+					return err
+				}
+				recordFiles, err := pm.RecordCli().ListAllFilesWithFilter(cmd.Context(), recordName, "recursive=\"true\"")
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				for _, f := range recordFiles {
+					if strings.HasSuffix(f.Filename, "/") {
+						continue
+					}
+					files = append(files, filestats.File{Owner: recordName.RecordID, Path: f.Filename, Size: f.Size, Sha256: f.Sha256})
+				}
+				return nil
+			})
+			for i, err := range errs {
+				if err != nil {
+					log.Fatalf("unable to list files of record %s: %v", records[i].Name, err)
+				}
+			}
+
+			report := printable.NewDedupeReport(filestats.Duplicates(files))
+			p, err := printer.Printer(outputFormat, &printer.Options{TableOpts: &table.PrintOpts{Verbose: verbose}})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err = p.PrintObj(report, io.Out); err != nil {
+				log.Fatalf("unable to print dedupe report: %v", err)
+			}
+			io.Eprintf("%d duplicated contents across %d records, %s wasted\n",
+				len(report.Delegate), len(records), printutils.FormatBytes(uint64(report.TotalWasted())))
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().BoolVar(&includeArchive, "include-archive", false, "include archived records")
+	filterFlags.Register(cmd)
+	cmd.Flags().IntVarP(&parallel, "parallel", "P", 4, "number of records whose files are listed in parallel")
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "show full sha256 hashes")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|json|yaml|csv)")
+
+	return cmd
+}
@@ -46,6 +46,8 @@ func NewFileCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.AddCommand(NewFileDownloadCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewFileUploadCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewFileDeleteCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewFileTreeCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewFileCopyToRecordCommand(cfgPath, io, getProvider))
 
 	return cmd
 }
@@ -0,0 +1,105 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	upload_utils "github.com/coscene-io/cocli/pkg/cmd_utils/upload_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewFileCopyToRecordCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		fileNames  []string
+		targetDir  = ""
+		force      = false
+		timeout    time.Duration
+		uploadOpts = &upload_utils.UploadManagerOpts{}
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "copy-to-record <project-resource-name/slug> <record-resource-name/id> --files <file1,file2,...> [--dir <target-dir>] [-f]",
+		Short:                 "Copy project files into a record",
+		Long:                  "Copy project files into a record of the project. Files are copied on the server side when supported, otherwise their content is streamed through presigned urls without being stored locally.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(fileNames) == 0 {
+				log.Fatalf("--files must be specified")
+			}
+
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			projectName, err := pm.ProjectName(cmd.Context(), args[0])
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			recordName, err := pm.RecordCli().RecordId2Name(cmd.Context(), args[1], projectName)
+			if utils.IsConnectErrorWithCode(err, connect.CodeNotFound) {
+				io.Printf("failed to find record: %s in project: %s\n", args[1], projectName)
+				return
+			} else if err != nil {
+				log.Fatalf("unable to get record name from %s: %v", args[1], err)
+			}
+
+			pairs := upload_utils.TransferPairs(fileNames, targetDir)
+			if !force {
+				io.Printf("About to copy %d files from %s to %s.\n", len(pairs), projectName, recordName)
+				for _, pair := range pairs {
+					io.Printf("  - %s -> %s\n", pair.Src, pair.Dst)
+				}
+				if confirmed := prompts.PromptYN("Do you want to continue?", io); !confirmed {
+					io.Println("Copy operation cancelled.")
+					return
+				}
+			}
+
+			streamed, err := upload_utils.TransferFiles(cmd.Context(),
+				&upload_utils.ApiOpts{SecurityTokenInterface: pm.SecurityTokenCli(), FileInterface: pm.FileCli()}, uploadOpts, timeout,
+				projectName, upload_utils.NewProjectParent(projectName), upload_utils.NewRecordParent(recordName), pairs)
+			if err != nil {
+				log.Fatalf("failed to copy files: %v", err)
+			}
+			if streamed {
+				io.Println("Server side copy is not available, file contents were streamed instead.")
+			}
+			io.Printf("Successfully copied %d files to %s.\n", len(pairs), recordName)
+
+			recordUrl, err := pm.GetRecordUrl(cmd.Context(), recordName)
+			if err != nil {
+				log.Errorf("unable to get record url: %v", err)
+			} else {
+				io.Printf("View copied files at: %s\n", recordUrl)
+			}
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&fileNames, "files", []string{}, "exact filenames to copy (comma-separated)")
+	cmd.Flags().StringVarP(&targetDir, "dir", "d", "", "target directory in the record")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "force copy without confirmation")
+	cmd.Flags().IntVarP(&uploadOpts.Threads, "parallel", "P", 4, "number of files streamed in parallel when server side copy is not available")
+	cmd.Flags().StringVarP(&uploadOpts.PartSize, "part-size", "s", "128Mib", "each part size when streaming")
+	cmd.Flags().DurationVar(&timeout, "response-timeout", 5*time.Minute, "server response time out when streaming")
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"strings"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewFileTreeCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		verbose      = false
+		outputFormat = ""
+		depth        = 0
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "tree <project-resource-name/slug> [--depth <n>] [-v] [-o <format>]",
+		Short:                 "Show files of a project as a directory tree with counts and sizes",
+		Args:                  cobra.ExactArgs(1),
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			if depth < 0 {
+				log.Fatalf("--depth must be >= 0")
+			}
+
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			projectName, err := pm.ProjectName(cmd.Context(), args[0])
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			projectFiles, err := pm.ProjectCli().ListAllFilesWithFilter(cmd.Context(), projectName, "recursive=\"true\"")
+			if err != nil {
+				log.Fatalf("unable to list files: %v", err)
+			}
+
+			var files []filestats.File
+			for _, f := range projectFiles {
+				// Skip directory markers, directories are derived from file paths.
+				if strings.HasSuffix(f.Filename, "/") {
+					continue
+				}
+				files = append(files, filestats.File{Path: f.Filename, Size: f.Size})
+			}
+
+			p, err := printer.Printer(outputFormat, &printer.Options{TableOpts: &table.PrintOpts{
+				Verbose: verbose,
+			}})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err = p.PrintObj(printable.NewFileTree(filestats.BuildTree(files).Prune(depth)), io.Out); err != nil {
+				log.Fatalf("unable to print file tree: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "show the full path of every file and directory")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|json|yaml)")
+	cmd.Flags().IntVar(&depth, "depth", 0, "maximum depth of directories to show (0 for unlimited)")
+
+	return cmd
+}
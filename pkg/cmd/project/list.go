@@ -16,6 +16,7 @@ package project
 
 import (
 	"context"
+	"strings"
 
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	"github.com/coscene-io/cocli/api"
@@ -24,6 +25,7 @@ import (
 	"github.com/coscene-io/cocli/internal/iostreams"
 	"github.com/coscene-io/cocli/internal/printer"
 	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -38,10 +40,12 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 		all            = false
 		keywords       []string
 		includeArchive = false
+		sortBy         = ""
+		desc           = false
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "list [-v] [--page-size <size>] [--page <number>] [--all] [--keywords <keyword1,keyword2>] [--include-archive]",
+		Use:                   "list [-v] [--page-size <size>] [--page <number>] [--all] [--keywords <keyword1,keyword2>] [--include-archive] [--sort-by <field> [--desc]]",
 		Short:                 "List projects in the current organization",
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.ExactArgs(0),
@@ -53,6 +57,10 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 			if page < 1 {
 				log.Fatalf("--page must be >= 1")
 			}
+			order, err := utils.ParseSortOrder(sortBy, desc, projectSortFields)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
 
 			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
 
@@ -62,7 +70,6 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 			}
 
 			var projects []*openv1alpha1resource.Project
-			var err error
 
 			if all {
 				projects, err = pm.ProjectCli().ListAllUserProjects(context.Background(), opts)
@@ -100,6 +107,10 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 				}
 			}
 
+			// ListProjects has no order_by, so a page is sorted on its own; only
+			// --all orders across every project.
+			utils.SortBy(projects, order, projectCompare)
+
 			// Build file system info map for human-readable output
 			fsInfo := make(map[string]*openv1alpha1resource.FileSystem)
 			if fileSystems, fsErr := pm.FileSystemCli().ListAllFileSystems(context.Background()); fsErr == nil {
@@ -128,9 +139,25 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.Flags().BoolVar(&all, "all", false, "list all projects (overrides default page size)")
 	cmd.Flags().StringSliceVar(&keywords, "keywords", []string{}, "filter by keywords in project name (comma-separated)")
 	cmd.Flags().BoolVar(&includeArchive, "include-archive", false, "include archived projects")
+	cmd.Flags().StringVar(&sortBy, "sort-by", "", "sort projects by field ("+strings.Join(projectSortFields, "|")+"), within the page unless --all is set")
+	cmd.Flags().BoolVar(&desc, "desc", false, "sort in descending order, requires --sort-by")
 
 	cmd.MarkFlagsMutuallyExclusive("all", "page-size")
 	cmd.MarkFlagsMutuallyExclusive("all", "page")
 
 	return cmd
 }
+
+var projectSortFields = []string{utils.SortCreateTime, utils.SortUpdateTime, utils.SortTitle}
+
+var projectCompare = map[string]func(a, b *openv1alpha1resource.Project) int{
+	utils.SortCreateTime: func(a, b *openv1alpha1resource.Project) int {
+		return a.GetCreateTime().AsTime().Compare(b.GetCreateTime().AsTime())
+	},
+	utils.SortUpdateTime: func(a, b *openv1alpha1resource.Project) int {
+		return a.GetUpdateTime().AsTime().Compare(b.GetUpdateTime().AsTime())
+	},
+	utils.SortTitle: func(a, b *openv1alpha1resource.Project) int {
+		return strings.Compare(a.GetDisplayName(), b.GetDisplayName())
+	},
+}
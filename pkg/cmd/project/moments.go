@@ -0,0 +1,217 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func NewMomentsCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug    = ""
+		search         = ""
+		includeArchive = false
+		filterFlags    = &cmd_utils.RecordFilterFlags{}
+		since          = ""
+		until          = ""
+		rule           = ""
+		parallel       = 0
+		outputFormat   = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:   "moments [-p <working-project-slug>] [-s <search or @saved-search> | <filter flags>] [--since <time>] [--until <time>] [--rule <rule-name>] [-o <output-format>]",
+		Short: "List the moments of all records in a project",
+		Long: `List the moments of all records in a project, or of the records matching
+--search or the record filter flags. --search also takes a saved search
+reference such as @nightly.
+
+Each moment is shown with its record. Wide and csv output add the description
+and one column per attribute (attribute.<key>) and custom field (custom.<field>).
+ndjson prints one JSON object per moment.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			filter, err := newMomentFilter(since, until, rule, time.Now())
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+			ref, err := cmd_utils.SavedSearchRef(cmd, nil, search)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			searchOptions := &api.SearchRecordsOptions{
+				Project:        proj,
+				IncludeArchive: includeArchive,
+			}
+			if err = cmd_utils.ResolveSelection(cmd.Context(), pm, proj, ref, search, filterFlags, searchOptions); err != nil {
+				log.Fatalf("%v", err)
+			}
+			records, err := pm.RecordCli().SearchAll(cmd.Context(), searchOptions)
+			if err != nil {
+				log.Fatalf("unable to search records: %v", err)
+			}
+
+			recordMoments := make([][]*printable.RecordMoment, len(records))
+			errs := utils.ParallelFor(records, parallel, func(i int, r *openv1alpha1resource.Record) error {
+				recordName, err := name.NewRecord(r.Name)
+				if err != nil {
+					return err
+				}
+				events, err := pm.RecordCli().ListAllEvents(cmd.Context(), recordName)
+				if err != nil {
+					return err
+				}
+				moments, err := pm.RecordCli().EventsToMoments(cmd.Context(), filter.apply(events))
+				if err != nil {
+					return err
+				}
+				for _, m := range moments {
+					recordMoments[i] = append(recordMoments[i], &printable.RecordMoment{RecordID: recordName.RecordID, RecordTitle: r.Title, Moment: m})
+				}
+				return nil
+			})
+			for i, err := range errs {
+				if err != nil {
+					log.Fatalf("unable to list moments of record %s: %v", records[i].Name, err)
+				}
+			}
+
+			var all []*printable.RecordMoment
+			for _, moments := range recordMoments {
+				all = append(all, moments...)
+			}
+			result := printable.NewRecordMoments(all)
+
+			if outputFormat == "ndjson" {
+				for _, msg := range result.ToProtoMessages() {
+					raw, err := protojson.Marshal(msg)
+					if err != nil {
+						log.Fatalf("unable to print moments: %v", err)
+					}
+					// protojson randomizes whitespace, compact it for stable lines.
+					var buf bytes.Buffer
+					if err = json.Compact(&buf, raw); err != nil {
+						log.Fatalf("unable to print moments: %v", err)
+					}
+					io.Println(buf.String())
+				}
+			} else {
+				tableOpts := &table.PrintOpts{}
+				format := outputFormat
+				if format == "wide" {
+					format = "table"
+					tableOpts.Wide = true
+				}
+				p, err := printer.Printer(format, &printer.Options{TableOpts: tableOpts})
+				if err != nil {
+					log.Fatal(err)
+				}
+				if err = p.PrintObj(result, io.Out); err != nil {
+					log.Fatalf("unable to print moments: %v", err)
+				}
+			}
+			io.Eprintf("%d moments across %d records\n", len(all), len(records))
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().StringVarP(&search, "search", "s", "", `only moments of the records matching this search query, e.g. 'labels:"night"', JSON Logic or @<saved-search>`)
+	cmd.Flags().BoolVar(&includeArchive, "include-archive", false, "include archived records")
+	filterFlags.Register(cmd)
+	cmd.Flags().StringVar(&since, "since", "", "only moments triggered at or after this time (RFC3339, date, or a duration ago such as 7d)")
+	cmd.Flags().StringVar(&until, "until", "", "only moments triggered before this time (RFC3339, date, or a duration ago such as 7d)")
+	cmd.Flags().StringVar(&rule, "rule", "", "only moments created by this diagnosis rule, by resource name or id")
+	cmd.Flags().IntVarP(&parallel, "parallel", "P", 4, "number of records whose moments are listed in parallel")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|wide|csv|json|yaml|ndjson)")
+
+	return cmd
+}
+
+// momentFilter selects moments by trigger time and creating rule. Zero
+// fields match everything.
+type momentFilter struct {
+	since time.Time
+	until time.Time
+	rule  string
+}
+
+func newMomentFilter(since, until, rule string, now time.Time) (*momentFilter, error) {
+	f := &momentFilter{rule: rule}
+	for _, t := range []struct {
+		flag  string
+		value string
+		dst   *time.Time
+	}{
+		{"since", since, &f.since},
+		{"until", until, &f.until},
+	} {
+		if t.value == "" {
+			continue
+		}
+		parsed, err := utils.ParseTimeOrAgo(t.value, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", t.flag, err)
+		}
+		*t.dst = parsed
+	}
+	return f, nil
+}
+
+func (f *momentFilter) apply(events []*openv1alpha1resource.Event) []*openv1alpha1resource.Event {
+	var ret []*openv1alpha1resource.Event
+	for _, e := range events {
+		trigger := e.GetTriggerTime().AsTime()
+		if !f.since.IsZero() && trigger.Before(f.since) {
+			continue
+		}
+		if !f.until.IsZero() && !trigger.Before(f.until) {
+			continue
+		}
+		if f.rule != "" {
+			ruleName := e.GetRule().GetName()
+			if ruleName == "" || (ruleName != f.rule && ruleName[strings.LastIndex(ruleName, "/")+1:] != f.rule) {
+				continue
+			}
+		}
+		ret = append(ret, e)
+	}
+	return ret
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"testing"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMomentFilter(t *testing.T) {
+	now := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	events := []*openv1alpha1resource.Event{
+		{DisplayName: "old", TriggerTime: timestamppb.New(now.Add(-72 * time.Hour))},
+		{DisplayName: "recent", TriggerTime: timestamppb.New(now.Add(-time.Hour)), Rule: &openv1alpha1resource.DiagnosisRule{Name: "projects/p1/diagnosisRules/brake"}},
+		{DisplayName: "manual", TriggerTime: timestamppb.New(now.Add(-time.Hour))},
+	}
+	names := func(f *momentFilter) []string {
+		return lo.Map(f.apply(events), func(e *openv1alpha1resource.Event, _ int) string { return e.DisplayName })
+	}
+
+	tests := []struct {
+		name     string
+		since    string
+		until    string
+		rule     string
+		expected []string
+	}{
+		{"no filter", "", "", "", []string{"old", "recent", "manual"}},
+		{"since", "1d", "", "", []string{"recent", "manual"}},
+		{"until", "", "2025-01-08T00:00:00Z", "", []string{"old"}},
+		{"rule by id", "", "", "brake", []string{"recent"}},
+		{"rule by name", "", "", "projects/p1/diagnosisRules/brake", []string{"recent"}},
+		{"unknown rule", "", "", "other", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newMomentFilter(tt.since, tt.until, tt.rule, now)
+			require.NoError(t, err)
+			if tt.expected == nil {
+				assert.Empty(t, f.apply(events))
+				return
+			}
+			assert.Equal(t, tt.expected, names(f))
+		})
+	}
+
+	_, err := newMomentFilter("yesterday", "", "", now)
+	assert.ErrorContains(t, err, "invalid --since")
+}
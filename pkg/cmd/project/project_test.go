@@ -43,7 +43,7 @@ func TestProjectCommand(t *testing.T) {
 		assert.Equal(t, "project", cmd.Use)
 		assert.NotEmpty(t, cmd.Short)
 
-		expectedSubcommands := []string{"list", "create", "file"}
+		expectedSubcommands := []string{"list", "create", "file", "usage", "custom-fields", "dedupe-report", "moments"}
 
 		for _, expected := range expectedSubcommands {
 			found := false
@@ -67,7 +67,7 @@ func TestProjectCommand(t *testing.T) {
 		listCmd, _, err := cmd.Find([]string{"list"})
 		require.NoError(t, err)
 
-		for _, flag := range []string{"all", "keywords", "page", "page-size", "output", "include-archive"} {
+		for _, flag := range []string{"all", "keywords", "page", "page-size", "output", "include-archive", "sort-by", "desc"} {
 			assert.NotNil(t, listCmd.Flag(flag), "Flag --%s not found", flag)
 		}
 	})
@@ -95,7 +95,7 @@ func TestProjectCommand(t *testing.T) {
 		fileCmd, _, err := cmd.Find([]string{"file"})
 		require.NoError(t, err)
 
-		expectedSubcmds := []string{"list", "upload", "download"}
+		expectedSubcmds := []string{"list", "upload", "download", "tree", "copy-to-record"}
 		for _, expected := range expectedSubcmds {
 			found := false
 			for _, sub := range fileCmd.Commands() {
@@ -30,6 +30,10 @@ func NewRootCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.AddCommand(NewListCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewCreateCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewFileCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewUsageCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewCustomFieldsCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewDedupeReportCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewMomentsCommand(cfgPath, io, getProvider))
 	return cmd
 }
 
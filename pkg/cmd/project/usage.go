@@ -0,0 +1,169 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/filestats"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewUsageCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug    = ""
+		groupBy        = ""
+		includeArchive = false
+		filterFlags    = &cmd_utils.RecordFilterFlags{}
+		withFiles      = false
+		top            = 0
+		parallel       = 0
+		outputFormat   = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "usage [-p <working-project-slug>] [--group-by label|month] [--include-archive] [<filter flags>] [--files [--top <n>]] [-o <output-format>]",
+		Short:                 "Report storage usage of a project",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			if groupBy != "" && groupBy != "label" && groupBy != "month" {
+				log.Fatalf("--group-by must be one of: label, month")
+			}
+
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			searchOptions := &api.SearchRecordsOptions{
+				Project:        proj,
+				IncludeArchive: includeArchive,
+			}
+			if err = filterFlags.Apply(cmd.Context(), pm, proj, searchOptions); err != nil {
+				log.Fatalf("unable to build record filter: %v", err)
+			}
+			records, err := pm.RecordCli().SearchAll(cmd.Context(), searchOptions)
+			if err != nil {
+				log.Fatalf("unable to search records: %v", err)
+			}
+
+			total := &filestats.Group{}
+			groups := make(map[string]*filestats.Group)
+			for _, r := range records {
+				addRecordUsage(total, r)
+				for _, key := range usageGroupKeys(r, groupBy) {
+					g, ok := groups[key]
+					if !ok {
+						g = &filestats.Group{Key: key}
+						groups[key] = g
+					}
+					addRecordUsage(g, r)
+				}
+			}
+
+			var fileStats *filestats.Stats
+			if withFiles {
+				var (
+					mu    sync.Mutex
+					files []filestats.File
+				)
+				errs := utils.ParallelFor(records, parallel, func(_ int, r *openv1alpha1resource.Record) error {
+					recordName, err := name.NewRecord(r.Name)
+					if err != nil {
+						return err
+					}
+					recordFiles, err := pm.RecordCli().ListAllFilesWithFilter(cmd.Context(), recordName, "recursive=\"true\"")
+					if err != nil {
+						return err
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					for _, f := range recordFiles {
+						if strings.HasSuffix(f.Filename, "/") {
+							continue
+						}
+						files = append(files, filestats.File{Owner: recordName.RecordID, Path: f.Filename, Size: f.Size})
+					}
+					return nil
+				})
+				for i, err := range errs {
+					if err != nil {
+						log.Fatalf("unable to list files of record %s: %v", records[i].Name, err)
+					}
+				}
+				fileStats = filestats.Compute(files, top)
+			}
+
+			p, err := printer.Printer(outputFormat, &printer.Options{})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err = p.PrintObj(printable.NewProjectUsage(groupBy, total, filestats.SortGroups(groups), fileStats), io.Out); err != nil {
+				log.Fatalf("unable to print usage: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().StringVar(&groupBy, "group-by", "", "group records by label or by creation month (label|month)")
+	cmd.Flags().BoolVar(&includeArchive, "include-archive", false, "include archived records")
+	filterFlags.Register(cmd)
+	cmd.Flags().BoolVar(&withFiles, "files", false, "list the files of every record for a breakdown by extension and directory")
+	cmd.Flags().IntVar(&top, "top", 10, "number of largest files listed with --files")
+	cmd.Flags().IntVarP(&parallel, "parallel", "P", 4, "number of records whose files are listed in parallel")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|json|yaml|csv)")
+
+	return cmd
+}
+
+func addRecordUsage(g *filestats.Group, r *openv1alpha1resource.Record) {
+	g.Records++
+	g.Files += r.FileSize
+	g.Bytes += r.ByteSize
+}
+
+// usageGroupKeys returns the groups a record counts towards. A record with
+// several labels counts towards each of them.
+func usageGroupKeys(r *openv1alpha1resource.Record, groupBy string) []string {
+	switch groupBy {
+	case "label":
+		if len(r.Labels) == 0 {
+			return []string{filestats.NoneKey}
+		}
+		keys := make([]string, 0, len(r.Labels))
+		for _, l := range r.Labels {
+			keys = append(keys, l.DisplayName)
+		}
+		return keys
+	case "month":
+		return []string{r.CreateTime.AsTime().In(time.Local).Format("2006-01")}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,382 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/customfield"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/internal/recordspec"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils/upload_utils"
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// applyPlan is the set of changes needed to bring one record in line with its spec.
+type applyPlan struct {
+	spec     *recordspec.Record
+	existing *openv1alpha1resource.Record // nil if the record will be created
+
+	customFieldValues []*commons.CustomFieldValue
+	updatePaths       []string
+	changes           []string
+	newMoments        []*recordspec.Moment
+}
+
+func NewApplyCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug = ""
+		specFile    = ""
+		yes         = false
+		dryRun      = false
+		multiOpts   = &upload_utils.UploadManagerOpts{}
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "apply -f <records.yaml> [-p <working-project-slug>] [--dry-run] [-y]",
+		Short:                 "Create or update records from a YAML spec",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			spec, err := recordspec.Load(specFile)
+			if err != nil {
+				log.Fatalf("unable to load spec: %v", err)
+			}
+
+			// Get current profile.
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			schema, err := pm.CustomFieldCli().GetRecordCustomFieldSchema(cmd.Context(), proj)
+			if err != nil {
+				log.Fatalf("unable to get custom field schema: %v", err)
+			}
+			resolver := customfield.NewResolver(schema, pm.UserCli())
+
+			plans := make([]*applyPlan, 0, len(spec.Records))
+			for _, rs := range spec.Records {
+				plan, err := buildApplyPlan(cmd.Context(), pm, proj, resolver, spec.KeyField, rs)
+				if err != nil {
+					log.Fatalf("unable to plan record %q: %v", rs.Title, err)
+				}
+				plans = append(plans, plan)
+			}
+
+			printApplyPlan(io, plans)
+			if dryRun {
+				return
+			}
+
+			if !yes && !prompts.PromptYN("Apply these changes?", io) {
+				io.Println("Apply aborted.")
+				return
+			}
+
+			failed := 0
+			for _, plan := range plans {
+				if err = executeApplyPlan(cmd.Context(), pm, proj, plan, multiOpts, timeout); err != nil {
+					failed++
+					log.Errorf("failed to apply record %q: %v", plan.spec.Title, err)
+				}
+			}
+
+			io.Printf("Applied %d / %d records.\n", len(plans)-failed, len(plans))
+			if failed > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&specFile, "file", "f", "", "path of the YAML spec describing the records")
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "apply without confirmation")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print the plan")
+	cmd.Flags().IntVarP(&multiOpts.Threads, "parallel", "P", 4, "number of uploads (could be part) in parallel")
+	cmd.Flags().StringVarP(&multiOpts.PartSize, "part-size", "s", "128Mib", "each part size")
+	cmd.Flags().DurationVar(&timeout, "response-timeout", 5*time.Minute, "server response time out")
+	cmd.Flags().BoolVar(&multiOpts.NoTTY, "no-tty", false, "disable interactive mode for headless environments")
+	cmd.Flags().BoolVar(&multiOpts.TTY, "tty", false, "force interactive mode even in headless environments")
+
+	_ = cmd.MarkFlagRequired("file")
+	cmd.MarkFlagsMutuallyExclusive("no-tty", "tty")
+
+	return cmd
+}
+
+// applyMatches keeps the records whose title equals the spec title and, with a
+// key field, whose value of that field equals the spec's. The title search
+// matches keywords, so it also returns records that merely contain the title.
+func applyMatches(records []*openv1alpha1resource.Record, title string, keyField string, cfvs []*commons.CustomFieldValue) []*openv1alpha1resource.Record {
+	keyValue, _ := lo.Find(cfvs, func(v *commons.CustomFieldValue) bool { return v.GetProperty().GetName() == keyField })
+	return lo.Filter(records, func(r *openv1alpha1resource.Record, _ int) bool {
+		if r.GetTitle() != title {
+			return false
+		}
+		if keyField == "" {
+			return true
+		}
+		existing, ok := lo.Find(r.CustomFieldValues, func(v *commons.CustomFieldValue) bool { return v.GetProperty().GetName() == keyField })
+		return ok && sameCustomFieldValue(existing, keyValue)
+	})
+}
+
+// buildApplyPlan finds the record matching the spec and computes the changes to apply.
+func buildApplyPlan(ctx context.Context, pm *config.ProfileManager, proj *name.Project, resolver *customfield.Resolver, keyField string, rs *recordspec.Record) (*applyPlan, error) {
+	cfvs, err := resolver.Resolve(ctx, rs.CustomFieldArgs())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve custom fields: %w", err)
+	}
+	plan := &applyPlan{spec: rs, customFieldValues: cfvs}
+
+	candidates, err := pm.RecordCli().SearchAll(ctx, &api.SearchRecordsOptions{
+		Project:        proj,
+		Titles:         []string{rs.Title},
+		IncludeArchive: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	candidates = applyMatches(candidates, rs.Title, keyField, cfvs)
+	if len(candidates) > 1 {
+		return nil, fmt.Errorf("%d existing records match, the key is ambiguous", len(candidates))
+	}
+
+	if len(candidates) == 0 {
+		if rs.Description != nil && *rs.Description != "" {
+			plan.changes = append(plan.changes, "description")
+		}
+		if len(rs.Labels) > 0 {
+			plan.changes = append(plan.changes, "labels: "+strings.Join(rs.Labels, ", "))
+		}
+		for _, v := range cfvs {
+			plan.changes = append(plan.changes, "custom field: "+v.GetProperty().GetName())
+		}
+		if rs.Device != "" {
+			plan.changes = append(plan.changes, "device: "+rs.Device)
+		}
+		plan.newMoments = rs.Moments
+		return plan, nil
+	}
+
+	existing := candidates[0]
+	plan.existing = existing
+
+	if rs.Description != nil && *rs.Description != existing.Description {
+		plan.updatePaths = append(plan.updatePaths, "description")
+		plan.changes = append(plan.changes, "description")
+	}
+
+	if rs.Labels != nil {
+		current := mapset.NewSet(lo.Map(existing.Labels, func(l *openv1alpha1resource.Label, _ int) string { return l.DisplayName })...)
+		desired := mapset.NewSet(rs.Labels...)
+		if !current.Equal(desired) {
+			plan.updatePaths = append(plan.updatePaths, "labels")
+			added := lo.Map(desired.Difference(current).ToSlice(), func(l string, _ int) string { return "+" + l })
+			removed := lo.Map(current.Difference(desired).ToSlice(), func(l string, _ int) string { return "-" + l })
+			plan.changes = append(plan.changes, "labels: "+strings.Join(append(added, removed...), " "))
+		}
+	}
+
+	changedFields := lo.Filter(cfvs, func(v *commons.CustomFieldValue, _ int) bool {
+		existingValue, ok := lo.Find(existing.CustomFieldValues, func(e *commons.CustomFieldValue) bool {
+			return e.GetProperty().GetName() == v.GetProperty().GetName()
+		})
+		return !ok || !sameCustomFieldValue(existingValue, v)
+	})
+	if len(changedFields) > 0 {
+		// Keep the values of fields the spec does not mention.
+		specified := mapset.NewSet(lo.Map(cfvs, func(v *commons.CustomFieldValue, _ int) string { return v.GetProperty().GetName() })...)
+		kept := lo.Filter(existing.CustomFieldValues, func(v *commons.CustomFieldValue, _ int) bool {
+			return !specified.Contains(v.GetProperty().GetName())
+		})
+		plan.customFieldValues = append(kept, cfvs...)
+		plan.updatePaths = append(plan.updatePaths, "custom_field_values")
+		for _, v := range changedFields {
+			plan.changes = append(plan.changes, "custom field: "+v.GetProperty().GetName())
+		}
+	}
+
+	if len(rs.Moments) > 0 {
+		recordName, err := name.NewRecord(existing.Name)
+		if err != nil {
+			return nil, err
+		}
+		events, err := pm.RecordCli().ListAllEvents(ctx, recordName)
+		if err != nil {
+			return nil, err
+		}
+		plan.newMoments = lo.Filter(rs.Moments, func(m *recordspec.Moment, _ int) bool {
+			trigger, _ := m.Trigger()
+			return !lo.ContainsBy(events, func(e *openv1alpha1resource.Event) bool {
+				return e.DisplayName == m.Name && e.TriggerTime.AsTime().Equal(trigger)
+			})
+		})
+	}
+
+	return plan, nil
+}
+
+func printApplyPlan(io *iostreams.IOStreams, plans []*applyPlan) {
+	var toCreate, toUpdate, unchanged int
+	for _, plan := range plans {
+		switch {
+		case plan.existing == nil:
+			toCreate++
+			io.Printf("+ create %q\n", plan.spec.Title)
+		case len(plan.updatePaths) > 0 || len(plan.newMoments) > 0:
+			toUpdate++
+			io.Printf("~ update %q (%s)\n", plan.spec.Title, plan.existing.Name)
+		default:
+			unchanged++
+			io.Printf("= unchanged %q (%s)\n", plan.spec.Title, plan.existing.Name)
+		}
+		for _, change := range plan.changes {
+			io.Printf("    %s\n", change)
+		}
+		for _, f := range plan.spec.Files {
+			io.Printf("    sync files: %s -> %s\n", f.Local, lo.If(f.RemoteDir == "", "/").Else(f.RemoteDir))
+		}
+		if len(plan.newMoments) > 0 {
+			io.Printf("    moments: +%d\n", len(plan.newMoments))
+		}
+	}
+	io.Printf("\nPlan: %d to create, %d to update, %d unchanged.\n", toCreate, toUpdate, unchanged)
+}
+
+func executeApplyPlan(ctx context.Context, pm *config.ProfileManager, proj *name.Project, plan *applyPlan, multiOpts *upload_utils.UploadManagerOpts, timeout time.Duration) error {
+	rs := plan.spec
+
+	var labels []*openv1alpha1resource.Label
+	for _, displayName := range rs.Labels {
+		label, err := pm.LabelCli().GetByDisplayNameOrCreate(ctx, displayName, proj)
+		if err != nil {
+			return fmt.Errorf("failed to get or create label %s: %w", displayName, err)
+		}
+		labels = append(labels, label)
+	}
+
+	var (
+		recordName *name.Record
+		err        error
+	)
+	if plan.existing == nil {
+		deviceName := ""
+		if rs.Device != "" {
+			d, err := pm.DeviceCli().ResolveName(ctx, rs.Device)
+			if err != nil {
+				return fmt.Errorf("failed to resolve device %s: %w", rs.Device, err)
+			}
+			deviceName = d.String()
+		}
+		res, err := pm.RecordCli().Create(ctx, proj, rs.Title, deviceName, lo.FromPtr(rs.Description), labels, plan.customFieldValues)
+		if err != nil {
+			return fmt.Errorf("failed to create record: %w", err)
+		}
+		if recordName, err = name.NewRecord(res.Name); err != nil {
+			return err
+		}
+		log.Infof("created record %s", recordName)
+	} else {
+		if recordName, err = name.NewRecord(plan.existing.Name); err != nil {
+			return err
+		}
+		if len(plan.updatePaths) > 0 {
+			if err = pm.RecordCli().Update(ctx, recordName, rs.Title, lo.FromPtr(rs.Description), labels, plan.customFieldValues, plan.updatePaths); err != nil {
+				return fmt.Errorf("failed to update record: %w", err)
+			}
+			log.Infof("updated record %s", recordName)
+		}
+	}
+
+	// The upload manager takes a single target directory per run.
+	uploads := lo.GroupBy(rs.Files, func(f *recordspec.File) string { return f.RemoteDir })
+	var thumbnailUpload map[string]string
+	if plan.existing == nil && rs.Thumbnail != "" {
+		thumbnailUrl, err := pm.RecordCli().GenerateRecordThumbnailUploadUrl(ctx, recordName)
+		if err != nil {
+			return fmt.Errorf("failed to generate record thumbnail upload url: %w", err)
+		}
+		thumbnailUpload = map[string]string{rs.Thumbnail: thumbnailUrl}
+		if len(uploads) == 0 {
+			uploads[""] = nil
+		}
+	}
+	for _, remoteDir := range lo.Keys(uploads) {
+		um, err := upload_utils.NewUploadManagerFromConfig(proj, timeout,
+			&upload_utils.ApiOpts{SecurityTokenInterface: pm.SecurityTokenCli(), FileInterface: pm.FileCli()}, multiOpts)
+		if err != nil {
+			return fmt.Errorf("unable to create upload manager: %w", err)
+		}
+		paths := lo.Map(uploads[remoteDir], func(f *recordspec.File, _ int) string { return filepath.Clean(f.Local) })
+		if err = um.Run(ctx, upload_utils.NewRecordParent(recordName), &upload_utils.FileOpts{
+			Paths:             paths,
+			Recursive:         true,
+			TargetDir:         remoteDir,
+			AdditionalUploads: thumbnailUpload,
+		}); err != nil {
+			return fmt.Errorf("failed to upload files: %w", err)
+		}
+		thumbnailUpload = nil
+	}
+
+	for _, m := range plan.newMoments {
+		trigger, _ := m.Trigger()
+		duration, _ := m.DurationValue()
+		if _, err = pm.EventCli().ObtainEvent(ctx, proj.String(), &openv1alpha1resource.Event{
+			DisplayName:      m.Name,
+			Description:      m.Description,
+			TriggerTime:      timestamppb.New(trigger),
+			Duration:         durationpb.New(duration),
+			CustomizedFields: m.Attributes,
+			Record:           recordName.String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sameCustomFieldValue reports whether two custom field values hold the same value,
+// regardless of the property definition attached to them.
+func sameCustomFieldValue(a, b *commons.CustomFieldValue) bool {
+	return proto.Equal(a.GetText(), b.GetText()) &&
+		proto.Equal(a.GetNumber(), b.GetNumber()) &&
+		proto.Equal(a.GetEnums(), b.GetEnums()) &&
+		proto.Equal(a.GetTime(), b.GetTime()) &&
+		proto.Equal(a.GetUser(), b.GetUser())
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"testing"
+
+	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyMatches(t *testing.T) {
+	text := func(field, value string) *commons.CustomFieldValue {
+		return &commons.CustomFieldValue{
+			Property: &commons.Property{Name: field},
+			Value:    &commons.CustomFieldValue_Text{Text: &commons.TextValue{Value: value}},
+		}
+	}
+	record := func(name, title string, cfvs ...*commons.CustomFieldValue) *openv1alpha1resource.Record {
+		return &openv1alpha1resource.Record{Name: name, Title: title, CustomFieldValues: cfvs}
+	}
+	names := func(records []*openv1alpha1resource.Record) []string {
+		return lo.Map(records, func(r *openv1alpha1resource.Record, _ int) string { return r.Name })
+	}
+
+	// The keyword search for "run-1" also returns these.
+	candidates := []*openv1alpha1resource.Record{
+		record("projects/p/records/a", "run-1", text("vin", "V1")),
+		record("projects/p/records/b", "run-1 retry", text("vin", "V1")),
+		record("projects/p/records/c", "old run-1", text("vin", "V2")),
+	}
+
+	assert.Equal(t, []string{"projects/p/records/a"}, names(applyMatches(candidates, "run-1", "", nil)))
+	assert.Equal(t, []string{"projects/p/records/a"}, names(applyMatches(candidates, "run-1", "vin", []*commons.CustomFieldValue{text("vin", "V1")})))
+	assert.Empty(t, applyMatches(candidates, "run-1", "vin", []*commons.CustomFieldValue{text("vin", "V2")}))
+	assert.Empty(t, applyMatches(candidates, "run", "", nil))
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"fmt"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewArchiveCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	return newSetArchivedCommand(true, cfgPath, io, getProvider)
+}
+
+func NewUnarchiveCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	return newSetArchivedCommand(false, cfgPath, io, getProvider)
+}
+
+func newSetArchivedCommand(archived bool, cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		force       = false
+		projectSlug = ""
+		search      = ""
+		labels      []string
+		filterFlags = &cmd_utils.RecordFilterFlags{}
+	)
+
+	verb := lo.If(archived, "archive").Else("unarchive")
+
+	cmd := &cobra.Command{
+		Use:                   fmt.Sprintf("%s [<record-resource-name/id>... | @<saved-search>] [-p <working-project-slug>] [-s <search> | --labels <label1,label2> | <filter flags>] [-f]", verb),
+		Short:                 lo.If(archived, "Archive records").Else("Unarchive records"),
+		DisableFlagsInUseLine: true,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				if _, ok := config.ParseSavedSearchRef(args[0]); ok {
+					return nil
+				}
+			}
+			bulk := search != "" || len(labels) > 0 || filterFlags.IsSet()
+			if bulk && len(args) > 0 {
+				return fmt.Errorf("record arguments cannot be combined with --search, --labels or filter flags")
+			}
+			if !bulk && len(args) == 0 {
+				return fmt.Errorf("requires at least one record, or --search / --labels / filter flags")
+			}
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			ref, err := cmd_utils.SavedSearchRef(cmd, args, search)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
+			// Get current profile.
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			var recordNames []*name.Record
+			if len(args) > 0 && ref == "" {
+				for _, arg := range args {
+					recordName, err := pm.RecordCli().RecordId2Name(cmd.Context(), arg, proj)
+					if err != nil {
+						log.Fatalf("unable to get record name from %s: %v", arg, err)
+					}
+					recordNames = append(recordNames, recordName)
+				}
+			} else {
+				searchOptions := &api.SearchRecordsOptions{
+					Project:        proj,
+					Labels:         labels,
+					IncludeArchive: !archived,
+				}
+				if err = cmd_utils.ResolveSelection(cmd.Context(), pm, proj, ref, search, filterFlags, searchOptions); err != nil {
+					log.Fatalf("%v", err)
+				}
+				records, err := pm.RecordCli().SearchAll(cmd.Context(), searchOptions)
+				if err != nil {
+					log.Fatalf("unable to search records: %v", err)
+				}
+				records = lo.Filter(records, func(r *openv1alpha1resource.Record, _ int) bool { return r.IsArchived != archived })
+				if len(records) == 0 {
+					io.Printf("No records to %s.\n", verb)
+					return
+				}
+
+				p, err := printer.Printer("table", &printer.Options{})
+				if err != nil {
+					log.Fatal(err)
+				}
+				if err = p.PrintObj(printable.NewRecord(records, ""), io.Out); err != nil {
+					log.Fatalf("unable to print records: %v", err)
+				}
+				io.Println()
+
+				for _, r := range records {
+					recordName, err := name.NewRecord(r.Name)
+					if err != nil {
+						log.Fatalf("unable to parse record name %s: %v", r.Name, err)
+					}
+					recordNames = append(recordNames, recordName)
+				}
+			}
+
+			// Confirm.
+			if !force {
+				if confirmed := prompts.PromptYN(fmt.Sprintf("Are you sure you want to %s %d record(s)?", verb, len(recordNames)), io); !confirmed {
+					io.Printf("%s aborted.\n", lo.If(archived, "Archive").Else("Unarchive"))
+					return
+				}
+			}
+
+			failed := 0
+			for _, recordName := range recordNames {
+				if err = pm.RecordCli().SetArchived(cmd.Context(), recordName, archived); err != nil {
+					failed++
+					log.Errorf("failed to %s record %s: %v", verb, recordName, err)
+				}
+			}
+			if failed > 0 {
+				log.Fatalf("failed to %s %d of %d record(s)", verb, failed, len(recordNames))
+			}
+
+			io.Printf("Successfully %sd %d record(s).\n", verb, len(recordNames))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", force, fmt.Sprintf("%s without confirmation", verb))
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().StringVarP(&search, "search", "s", "", `search query selecting the records, e.g. 'labels:"night"', JSON Logic (from frontend advanced search) or @<saved-search>`)
+	cmd.Flags().StringSliceVar(&labels, "labels", []string{}, "select the records with these labels (comma-separated)")
+
+	cmd.MarkFlagsMutuallyExclusive("search", "labels")
+	filterFlags.Register(cmd)
+
+	return cmd
+}
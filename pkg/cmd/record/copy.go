@@ -15,6 +15,8 @@
 package record
 
 import (
+	"time"
+
 	"connectrpc.com/connect"
 	"github.com/coscene-io/cocli/internal/config"
 	"github.com/coscene-io/cocli/internal/iostreams"
@@ -22,6 +24,7 @@ import (
 	"github.com/coscene-io/cocli/internal/prompts"
 	"github.com/coscene-io/cocli/internal/utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils/upload_utils"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -31,11 +34,20 @@ func NewCopyCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 		projectSlug = ""
 		dstProject  = ""
 		force       = false
+		toProfile   = ""
+		timeout     time.Duration
+		uploadOpts  = &upload_utils.UploadManagerOpts{NoTTY: true}
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "copy <record-resource-name/id> [-p <working-project-slug>] [-P <dst-project-slug>] [-f]",
-		Short:                 "Copy a record to target project",
+		Use:   "copy <record-resource-name/id> [-p <working-project-slug>] [-P <dst-project-slug>] [--to-profile <profile>] [-f]",
+		Short: "Copy a record to target project",
+		Long: `Copy a record to target project.
+
+Within one organization the record is copied by the server. With --to-profile
+the record is recreated in a project of that profile, usually in another
+organization: metadata, labels, custom fields and moments are recreated, and
+files are streamed from the source into the target without a local copy.`,
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
@@ -56,6 +68,17 @@ func NewCopyCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 			} else if err != nil {
 				log.Fatalf("unable to get record name from %s: %v", args[0], err)
 			}
+			if toProfile != "" {
+				copyToProfile(cmd, recordName, toProfile, dstProject, force, &profileCopier{
+					src:        pm,
+					dst:        cmd_utils.ProfileManagerFor(cmd, getProvider, *cfgPath, toProfile),
+					timeout:    timeout,
+					uploadOpts: uploadOpts,
+					io:         io,
+				})
+				return
+			}
+
 			var (
 				dstProjectName *name.Project
 			)
@@ -111,6 +134,44 @@ func NewCopyCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
 	cmd.Flags().StringVarP(&dstProject, "dst-project", "P", "", "destination project slug")
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "force copy without confirmation")
+	cmd.Flags().StringVar(&toProfile, "to-profile", "", "recreate the record in a project of this profile, e.g. in another organization")
+	cmd.Flags().IntVar(&uploadOpts.Threads, "parallel", 4, "number of files copied in parallel with --to-profile")
+	cmd.Flags().StringVarP(&uploadOpts.PartSize, "part-size", "s", "128Mib", "each part size with --to-profile")
+	cmd.Flags().DurationVar(&timeout, "response-timeout", 5*time.Minute, "server response time out with --to-profile")
 
 	return cmd
 }
+
+func copyToProfile(cmd *cobra.Command, recordName *name.Record, toProfile string, dstProject string, force bool, copier *profileCopier) {
+	dstProjectName, err := copier.dst.ProjectName(cmd.Context(), dstProject)
+	if err != nil {
+		log.Fatalf("failed to get destination project name in profile %s: %v", toProfile, err)
+	}
+
+	if dstProject == "" {
+		dstProject = copier.dst.GetCurrentProfile().ProjectSlug
+	}
+	copier.io.Printf("Will recreate record %s in project %s of profile %s\n", recordName.RecordID, dstProject, toProfile)
+	if !force {
+		if confirmed := prompts.PromptYN("Are you sure you want to proceed with this copy operation?", copier.io); !confirmed {
+			copier.io.Println("Copy operation aborted.")
+			return
+		}
+	}
+
+	copied, err := copier.copy(cmd.Context(), recordName, dstProjectName)
+	if err != nil {
+		if copied != nil {
+			log.Fatalf("record %s was created but not completely copied: %v", copied.Name, err)
+		}
+		log.Fatalf("failed to copy record: %v", err)
+	}
+	copier.io.Printf("Record successfully copied to %s.\n", copied.Name)
+
+	copiedRecordName, _ := name.NewRecord(copied.Name)
+	if copiedRecordUrl, err := copier.dst.GetRecordUrl(cmd.Context(), copiedRecordName); err != nil {
+		log.Errorf("unable to get record url: %v", err)
+	} else {
+		copier.io.Println("View copied record at:", copiedRecordUrl)
+	}
+}
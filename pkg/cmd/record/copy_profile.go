@@ -0,0 +1,201 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/customfield"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils/upload_utils"
+)
+
+// profileCopier recreates a record in a project reached through another
+// profile, typically in another organization, where the server-side copy is
+// not available. Labels, custom fields and devices are matched by display
+// name, field name and serial number since their ids differ between
+// organizations.
+type profileCopier struct {
+	src, dst   *config.ProfileManager
+	timeout    time.Duration
+	uploadOpts *upload_utils.UploadManagerOpts
+	io         *iostreams.IOStreams
+}
+
+func (c *profileCopier) copy(ctx context.Context, recordName *name.Record, dstProj *name.Project) (*openv1alpha1resource.Record, error) {
+	rcd, err := c.src.RecordCli().Get(ctx, recordName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get record: %w", err)
+	}
+
+	// Resolve everything against the target before creating anything in it.
+	customFieldValues, err := c.customFieldValues(ctx, rcd.CustomFieldValues, dstProj)
+	if err != nil {
+		return nil, err
+	}
+	deviceName := c.deviceName(ctx, rcd)
+
+	labels := make([]*openv1alpha1resource.Label, 0, len(rcd.Labels))
+	for _, l := range rcd.Labels {
+		label, err := c.dst.LabelCli().GetByDisplayNameOrCreate(ctx, l.DisplayName, dstProj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get or create label %s: %w", l.DisplayName, err)
+		}
+		labels = append(labels, label)
+	}
+
+	copied, err := c.dst.RecordCli().Create(ctx, dstProj, rcd.Title, deviceName, rcd.Description, labels, customFieldValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create record: %w", err)
+	}
+	copiedName, err := name.NewRecord(copied.Name)
+	if err != nil {
+		return nil, err
+	}
+	c.io.Eprintf("Created record %s\n", copied.Name)
+
+	if err = c.copyFiles(ctx, recordName, copiedName, dstProj); err != nil {
+		return copied, err
+	}
+	if err = c.copyMoments(ctx, recordName, copiedName, dstProj); err != nil {
+		return copied, err
+	}
+	return copied, nil
+}
+
+func (c *profileCopier) customFieldValues(ctx context.Context, values []*commons.CustomFieldValue, dstProj *name.Project) ([]*commons.CustomFieldValue, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	inputs := make([]string, 0, len(values))
+	for _, v := range values {
+		input, err := customfield.ToInput(ctx, c.src.UserCli(), v)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, input)
+	}
+	resolved, err := customfield.ResolveCustomFields(ctx, c.dst.CustomFieldCli(), c.dst.UserCli(), dstProj, inputs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map custom fields to the target project: %w", err)
+	}
+	return resolved, nil
+}
+
+// deviceName returns the target device with the serial number of the record's
+// device, or "" with a warning when there is none.
+func (c *profileCopier) deviceName(ctx context.Context, rcd *openv1alpha1resource.Record) string {
+	if rcd.Device == nil {
+		return ""
+	}
+	serial := rcd.Device.SerialNumber
+	if serial == "" {
+		if deviceName, err := name.NewDevice(rcd.Device.Name); err == nil {
+			if d, err := c.src.DeviceCli().Get(ctx, deviceName); err == nil {
+				serial = d.SerialNumber
+			}
+		}
+	}
+	if serial == "" {
+		c.io.Eprintf("Warning: device %s has no serial number, the copy has no device\n", rcd.Device.Name)
+		return ""
+	}
+	d, err := c.dst.DeviceCli().ResolveName(ctx, serial)
+	if err != nil {
+		c.io.Eprintf("Warning: device %s not found in the target organization, the copy has no device\n", serial)
+		return ""
+	}
+	return d.String()
+}
+
+// copyFiles streams every file from the source's download urls into the
+// copied record without staging it on the local disk.
+func (c *profileCopier) copyFiles(ctx context.Context, src *name.Record, dst *name.Record, dstProj *name.Project) error {
+	files, err := c.src.RecordCli().ListAllFilesWithFilter(ctx, src, "recursive=\"true\"")
+	if err != nil {
+		return fmt.Errorf("failed to list files: %w", err)
+	}
+
+	sources := make([]upload_utils.StreamSource, 0, len(files))
+	for _, f := range files {
+		if strings.HasSuffix(f.Filename, "/") {
+			continue
+		}
+		fileName := f.Name
+		sources = append(sources, upload_utils.StreamSource{
+			Path:   f.Filename,
+			Size:   f.Size,
+			Sha256: f.Sha256,
+			Open: func(ctx context.Context) (io.ReadCloser, error) {
+				downloadUrl, err := c.src.FileCli().GenerateFileDownloadUrl(ctx, fileName)
+				if err != nil {
+					return nil, err
+				}
+				return cmd_utils.OpenDownloadUrl(ctx, downloadUrl)
+			},
+		})
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+
+	um, err := upload_utils.NewUploadManagerFromConfig(dstProj, c.timeout,
+		&upload_utils.ApiOpts{SecurityTokenInterface: c.dst.SecurityTokenCli(), FileInterface: c.dst.FileCli()}, c.uploadOpts)
+	if err != nil {
+		return fmt.Errorf("failed to create upload manager: %w", err)
+	}
+	if err = um.RunStreams(ctx, upload_utils.NewRecordParent(dst), sources); err != nil {
+		return fmt.Errorf("failed to copy files: %w", err)
+	}
+	return nil
+}
+
+// copyMoments recreates the moments of the record. Moment custom field values
+// are not copied since there is no moment schema to map them with.
+func (c *profileCopier) copyMoments(ctx context.Context, src *name.Record, dst *name.Record, dstProj *name.Project) error {
+	events, err := c.src.RecordCli().ListAllEvents(ctx, src)
+	if err != nil {
+		return fmt.Errorf("failed to list moments: %w", err)
+	}
+	for _, e := range events {
+		if len(e.CustomFieldValues) > 0 {
+			c.io.Eprintf("Warning: custom fields of moment %s are not copied\n", e.DisplayName)
+		}
+		_, err := c.dst.EventCli().ObtainEvent(ctx, dstProj.String(), &openv1alpha1resource.Event{
+			DisplayName:      e.DisplayName,
+			Description:      e.Description,
+			TriggerTime:      e.TriggerTime,
+			Duration:         e.Duration,
+			CustomizedFields: e.CustomizedFields,
+			Record:           dst.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to copy moment %s: %w", e.DisplayName, err)
+		}
+	}
+	if len(events) > 0 {
+		c.io.Eprintf("Copied %d moments\n", len(events))
+	}
+	return nil
+}
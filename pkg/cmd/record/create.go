@@ -15,6 +15,9 @@
 package record
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
@@ -36,14 +39,17 @@ func NewCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 		projectSlug       = ""
 		labelDisplayNames []string
 		customFieldStrs   []string
+		customFile        = ""
 		thumbnail         = ""
 		multiOpts         = &upload_utils.UploadManagerOpts{}
 		timeout           time.Duration
 		outputFormat      = ""
+		noCreateLabels    = false
+		device            = ""
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "create [-t <title>] [-d <description>] [-l <labels>...] [--custom <key=value>...] [-p <working-project-slug>] [-i <thumbnail>] [-o <output-format>]",
+		Use:                   "create [-t <title>] [-d <description>] [-l <labels>...] [--no-create-labels] [--custom <key=value>...] [--custom-file <values.json>] [--device <device>] [-p <working-project-slug>] [-i <thumbnail>] [-o <output-format>]",
 		Short:                 "Create a new record",
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.ExactArgs(0),
@@ -55,25 +61,35 @@ func NewCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 				log.Fatalf("unable to get project name: %v", err)
 			}
 
+			// Validate custom fields before creating any labels.
+			customFieldValues, err := resolveCustomFieldArgs(cmd.Context(), pm, proj, customFieldStrs, customFile)
+			if err != nil {
+				log.Fatalf("Failed to resolve custom fields: %v", err)
+			}
+
 			// Create record.
 			labelEntities := make([]*openv1alpha1resource.Label, 0)
 			for _, labelDisplayName := range labelDisplayNames {
-				labelEntity, err := pm.LabelCli().GetByDisplayNameOrCreate(cmd.Context(), labelDisplayName, proj)
-				if err != nil {
+				labelEntity, err := resolveLabel(cmd.Context(), pm, labelDisplayName, proj, noCreateLabels)
+				if err != nil && noCreateLabels {
+					log.Fatalf("Failed to get label %s: %v", labelDisplayName, err)
+				} else if err != nil {
 					log.Errorf("Failed to get or create label %s: %v", labelDisplayName, err)
 				} else {
 					labelEntities = append(labelEntities, labelEntity)
 				}
 			}
-			var customFieldValues []*commons.CustomFieldValue
-			if len(customFieldStrs) > 0 {
-				customFieldValues, err = customfield.ResolveCustomFields(cmd.Context(), pm.CustomFieldCli(), pm.UserCli(), proj, customFieldStrs)
+
+			deviceName := ""
+			if device != "" {
+				d, err := pm.DeviceCli().ResolveName(cmd.Context(), device)
 				if err != nil {
-					log.Fatalf("Failed to resolve custom fields: %v", err)
+					log.Fatalf("Failed to resolve device %s: %v", device, err)
 				}
+				deviceName = d.String()
 			}
 
-			res, err := pm.RecordCli().Create(cmd.Context(), proj, title, "", description, labelEntities, customFieldValues)
+			res, err := pm.RecordCli().Create(cmd.Context(), proj, title, deviceName, description, labelEntities, customFieldValues)
 			if err != nil {
 				log.Fatalf("Failed to create record: %v", err)
 			}
@@ -110,7 +126,10 @@ func NewCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 	cmd.Flags().StringVarP(&title, "title", "t", "cocli created record", "title of the record.")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "description of the record.")
 	cmd.Flags().StringSliceVarP(&labelDisplayNames, "labels", "l", []string{}, "labels of the record.")
+	cmd.Flags().BoolVar(&noCreateLabels, "no-create-labels", false, "fail instead of creating labels that do not exist")
 	cmd.Flags().StringArrayVar(&customFieldStrs, "custom", []string{}, `custom field values in key=value format (repeatable, e.g. --custom "color=blue" --custom "priority=high")`)
+	cmd.Flags().StringVar(&customFile, "custom-file", "", customFileUsage)
+	cmd.Flags().StringVar(&device, "device", "", "device that produced the record, by id, serial number or resource name")
 	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
 	cmd.Flags().StringVarP(&thumbnail, "thumbnail", "i", "", "thumbnail path of the record.")
 	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table|json|yaml)")
@@ -124,3 +143,46 @@ func NewCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 
 	return cmd
 }
+
+// resolveLabel looks up a label by display name, creating it unless noCreate is set.
+func resolveLabel(ctx context.Context, pm *config.ProfileManager, displayName string, proj *name.Project, noCreate bool) (*openv1alpha1resource.Label, error) {
+	if noCreate {
+		return pm.LabelCli().GetByDisplayName(ctx, displayName, proj)
+	}
+	return pm.LabelCli().GetByDisplayNameOrCreate(ctx, displayName, proj)
+}
+
+const customFileUsage = `JSON object of custom field values, e.g. {"priority": "high", "tags": ["a", "b"]}; --custom overrides its keys`
+
+// resolveCustomFieldArgs merges the values of --custom-file with --custom and
+// resolves them against the project schema. The file is validated in full
+// before anything is resolved, so every problem in it is reported at once.
+func resolveCustomFieldArgs(ctx context.Context, pm *config.ProfileManager, proj *name.Project, customArgs []string, customFile string) ([]*commons.CustomFieldValue, error) {
+	if len(customArgs) == 0 && customFile == "" {
+		return nil, nil
+	}
+
+	var fileValues map[string]any
+	if customFile != "" {
+		var err error
+		if fileValues, err = customfield.LoadValuesFile(customFile); err != nil {
+			return nil, err
+		}
+	}
+
+	schema, err := pm.CustomFieldCli().GetRecordCustomFieldSchema(ctx, proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field schema: %w", err)
+	}
+	resolver := customfield.NewResolver(schema, pm.UserCli())
+
+	for _, arg := range customArgs {
+		key, _, _ := strings.Cut(arg, "=")
+		delete(fileValues, key)
+	}
+	inputs, err := resolver.ValuesToInputs(fileValues)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s:\n%w", customFile, err)
+	}
+	return resolver.Resolve(ctx, append(inputs, customArgs...))
+}
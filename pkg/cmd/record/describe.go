@@ -16,10 +16,12 @@ package record
 
 import (
 	"context"
+	"strings"
 
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	"connectrpc.com/connect"
 	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/filestats"
 	"github.com/coscene-io/cocli/internal/iostreams"
 	"github.com/coscene-io/cocli/internal/name"
 	"github.com/coscene-io/cocli/internal/printer"
@@ -35,10 +37,12 @@ func NewDescribeCommand(cfgPath *string, io *iostreams.IOStreams, getProvider fu
 	var (
 		projectSlug  = ""
 		outputFormat = ""
+		withStats    = false
+		top          = 0
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "describe <record-resource-name/id> [-p <working-project-slug>] [-o <output-format>]",
+		Use:                   "describe <record-resource-name/id> [-p <working-project-slug>] [--stats [--top <n>]] [-o <output-format>]",
 		Short:                 "Describe record metadata",
 		Args:                  cobra.ExactArgs(1),
 		DisableFlagsInUseLine: true,
@@ -65,19 +69,34 @@ func NewDescribeCommand(cfgPath *string, io *iostreams.IOStreams, getProvider fu
 				log.Fatalf("unable to get record: %v", err)
 			}
 
+			var stats *filestats.Stats
+			if withStats {
+				files, err := pm.RecordCli().ListAllFilesWithFilter(cmd.Context(), recordName, "recursive=\"true\"")
+				if err != nil {
+					log.Fatalf("unable to list files: %v", err)
+				}
+				stats = filestats.Compute(recordFileStats(files), top)
+			}
+
 			// Display record in the requested format
-			DisplayRecordWithFormat(cmd.Context(), record, pm, outputFormat, false, io)
+			displayRecord(cmd.Context(), record, stats, pm, outputFormat, false, io)
 		},
 	}
 
 	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
 	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table|json|yaml)")
+	cmd.Flags().BoolVar(&withStats, "stats", false, "include file statistics computed from the record files")
+	cmd.Flags().IntVar(&top, "top", 5, "number of largest files listed with --stats")
 
 	return cmd
 }
 
 // DisplayRecordWithFormat displays record details in the specified format
 func DisplayRecordWithFormat(ctx context.Context, record *openv1alpha1resource.Record, pm *config.ProfileManager, format string, showSuccessMessage bool, io *iostreams.IOStreams) {
+	displayRecord(ctx, record, nil, pm, format, showSuccessMessage, io)
+}
+
+func displayRecord(ctx context.Context, record *openv1alpha1resource.Record, stats *filestats.Stats, pm *config.ProfileManager, format string, showSuccessMessage bool, io *iostreams.IOStreams) {
 	// Parse record name
 	recordName, err := name.NewRecord(record.Name)
 	if err != nil {
@@ -97,6 +116,7 @@ func DisplayRecordWithFormat(ctx context.Context, record *openv1alpha1resource.R
 
 	// Create wrapped record with metadata
 	recordWithMeta := printable.NewRecordWithMetadata(record, recordUrl)
+	recordWithMeta.Stats = stats
 
 	// Handle success message for table format
 	if showSuccessMessage && format == "table" {
@@ -122,3 +142,15 @@ func DisplayRecordWithFormat(ctx context.Context, record *openv1alpha1resource.R
 		io.Println("-------------------------------------------------------------")
 	}
 }
+
+// recordFileStats converts record files to the input of filestats, skipping directories.
+func recordFileStats(files []*openv1alpha1resource.File) []filestats.File {
+	ret := make([]filestats.File, 0, len(files))
+	for _, f := range files {
+		if strings.HasSuffix(f.Filename, "/") {
+			continue
+		}
+		ret = append(ret, filestats.File{Path: f.Filename, Size: f.Size})
+	}
+	return ret
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/filediff"
+	"github.com/coscene-io/cocli/internal/fs"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewDiffCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug   = ""
+		projectSlugB  = ""
+		profileB      = ""
+		includeHidden = false
+		verbose       = false
+		outputFormat  = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff <record-a-resource-name/id|local-dir> <record-b-resource-name/id|local-dir> [-p <working-project-slug>] [--project-b <project-slug>] [--profile-b <profile>] [-o <output-format>]",
+		Short: "Compare the files of two records",
+		Long: `Compare the files of two records, or of a record and a local directory.
+
+Lists files only in A, only in B, and files present in both with a different
+sha256 or size. An argument naming an existing local directory is compared as
+local files. Record B is looked up in --project-b with --profile-b, which
+default to the project and profile of record A.
+
+Exits with status 1 when the file sets differ.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			pmA := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			pmB := cmd_utils.ProfileManagerFor(cmd, getProvider, *cfgPath, profileB)
+			if profileB == "" && projectSlugB == "" {
+				projectSlugB = projectSlug
+			}
+
+			digestsA, err := loadDiffSide(cmd.Context(), pmA, projectSlug, args[0], includeHidden)
+			if err != nil {
+				log.Fatalf("unable to list files of %s: %v", args[0], err)
+			}
+			digestsB, err := loadDiffSide(cmd.Context(), pmB, projectSlugB, args[1], includeHidden)
+			if err != nil {
+				log.Fatalf("unable to list files of %s: %v", args[1], err)
+			}
+
+			entries := filediff.Compare(digestsA, digestsB)
+
+			if (outputFormat == "" || outputFormat == "table") && len(entries) == 0 {
+				io.Println("No differences.")
+				return
+			}
+			p, err := printer.Printer(outputFormat, &printer.Options{TableOpts: &table.PrintOpts{
+				Verbose: verbose,
+			}})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err = p.PrintObj(printable.NewFileDiff(args[0], args[1], entries), io.Out); err != nil {
+				log.Fatalf("unable to print diff: %v", err)
+			}
+			if len(entries) > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().StringVar(&projectSlugB, "project-b", "", "the slug of the project of record B (default the working project)")
+	cmd.Flags().StringVar(&profileB, "profile-b", "", "the profile used to look up record B (default the current profile)")
+	cmd.Flags().BoolVarP(&includeHidden, "include-hidden", "H", false, "include hidden files (\"dot\" files) of local directories")
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "show full sha256 digests")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|json|yaml)")
+
+	return cmd
+}
+
+// loadDiffSide returns the file digests of a local directory or of a record, keyed by relative path.
+func loadDiffSide(ctx context.Context, pm *config.ProfileManager, projectSlug string, arg string, includeHidden bool) (map[string]filediff.Digest, error) {
+	if info, err := os.Stat(arg); err == nil && info.IsDir() {
+		root, err := filepath.Abs(arg)
+		if err != nil {
+			return nil, err
+		}
+		ret := make(map[string]filediff.Digest)
+		for _, path := range fs.FindFiles(root, true, includeHidden) {
+			sha256, size, err := fs.CalSha256AndSize(path)
+			if err != nil {
+				return nil, fmt.Errorf("unable to calculate sha256 for %s: %w", path, err)
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return nil, err
+			}
+			ret[filepath.ToSlash(rel)] = filediff.Digest{Sha256: sha256, Size: size}
+		}
+		return ret, nil
+	}
+
+	proj, err := pm.ProjectName(ctx, projectSlug)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get project name: %w", err)
+	}
+	recordName, err := pm.RecordCli().RecordId2Name(ctx, arg, proj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get record name: %w", err)
+	}
+	files, err := pm.RecordCli().ListAllFilesWithFilter(ctx, recordName, "recursive=\"true\"")
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]filediff.Digest, len(files))
+	for _, f := range files {
+		if strings.HasSuffix(f.Filename, "/") {
+			continue
+		}
+		ret[f.Filename] = filediff.Digest{Sha256: f.Sha256, Size: f.Size}
+	}
+	return ret, nil
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/customfield"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// ensureTitleData is the data available to --title-template.
+type ensureTitleData struct {
+	// Device is the --device value as given, without a "devices/" prefix.
+	Device string
+	// Date and Time are the local date (2006-01-02) and time (15:04:05) of the invocation.
+	Date string
+	Time string
+	// Custom holds the raw --match-custom values by field name.
+	Custom map[string]string
+}
+
+func NewEnsureCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug       = ""
+		titleTemplate     = ""
+		description       = ""
+		device            = ""
+		matchCustom       []string
+		labelDisplayNames []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "ensure --title-template <template> [--match-custom <key=value>...] [--device <device>] [-l <labels>...] [-d <description>] [-p <working-project-slug>]",
+		Short: "Find the record matching a key or create it, and print its name",
+		Long: "Find the unarchived record whose title, device and --match-custom values match, or create it.\n" +
+			"The title is a Go template over .Device, .Date, .Time and .Custom, e.g. '{{.Device}}-{{.Date}}'.\n" +
+			"Concurrent invocations that both create a record converge on the oldest one and delete their duplicate.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			tmpl, err := template.New("title").Option("missingkey=error").Parse(titleTemplate)
+			if err != nil {
+				log.Fatalf("invalid --title-template: %v", err)
+			}
+			title, err := renderEnsureTitle(tmpl, strings.TrimPrefix(device, "devices/"), matchCustom, time.Now())
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
+			// Get current profile.
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			deviceID := ""
+			if device != "" {
+				deviceName, err := pm.DeviceCli().ResolveName(cmd.Context(), device)
+				if err != nil {
+					log.Fatalf("unable to resolve device %s: %v", device, err)
+				}
+				deviceID = deviceName.DeviceID
+			}
+
+			var customFieldValues []*commons.CustomFieldValue
+			if len(matchCustom) > 0 {
+				customFieldValues, err = customfield.ResolveCustomFields(cmd.Context(), pm.CustomFieldCli(), pm.UserCli(), proj, matchCustom)
+				if err != nil {
+					log.Fatalf("failed to resolve custom fields: %v", err)
+				}
+			}
+
+			key := &ensureKey{project: proj, title: title, deviceID: deviceID, customFieldValues: customFieldValues}
+			existing, err := key.find(cmd.Context(), pm)
+			if err != nil {
+				log.Fatalf("unable to search records: %v", err)
+			}
+			if existing != nil {
+				io.Eprintf("Found record %q.\n", title)
+				io.Println(existing.Name)
+				return
+			}
+
+			var labels []*openv1alpha1resource.Label
+			for _, displayName := range labelDisplayNames {
+				label, err := resolveLabel(cmd.Context(), pm, displayName, proj, false)
+				if err != nil {
+					log.Fatalf("failed to get or create label %s: %v", displayName, err)
+				}
+				labels = append(labels, label)
+			}
+
+			deviceName := ""
+			if deviceID != "" {
+				deviceName = "devices/" + deviceID
+			}
+			created, err := pm.RecordCli().Create(cmd.Context(), proj, title, deviceName, description, labels, customFieldValues)
+			if err != nil {
+				log.Fatalf("failed to create record: %v", err)
+			}
+
+			// Another invocation may have created the same record meanwhile:
+			// every invocation keeps the oldest match and drops its own duplicate.
+			winner, err := key.find(cmd.Context(), pm)
+			if err != nil {
+				log.Fatalf("unable to search records: %v", err)
+			}
+			if winner != nil && winner.Name != created.Name {
+				if createdName, err := name.NewRecord(created.Name); err == nil {
+					if err = pm.RecordCli().Delete(cmd.Context(), createdName); err != nil {
+						log.Warnf("unable to delete duplicate record %s: %v", created.Name, err)
+					}
+				}
+				io.Eprintf("Found record %q.\n", title)
+				io.Println(winner.Name)
+				return
+			}
+
+			io.Eprintf("Created record %q.\n", title)
+			io.Println(created.Name)
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().StringVar(&titleTemplate, "title-template", "", "Go template rendering the record title, e.g. '{{.Device}}-{{.Date}}'")
+	cmd.Flags().StringArrayVar(&matchCustom, "match-custom", []string{}, "custom field values the record must have, set when creating it (repeatable, key=value)")
+	cmd.Flags().StringVar(&device, "device", "", "device that produced the record, by id, serial number or resource name")
+	cmd.Flags().StringSliceVarP(&labelDisplayNames, "labels", "l", []string{}, "labels of a created record")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "description of a created record")
+
+	_ = cmd.MarkFlagRequired("title-template")
+
+	return cmd
+}
+
+// renderEnsureTitle renders the title template for a device, the raw
+// --match-custom values and the invocation time.
+func renderEnsureTitle(tmpl *template.Template, deviceID string, matchCustom []string, now time.Time) (string, error) {
+	data := &ensureTitleData{
+		Device: deviceID,
+		Date:   now.Format(time.DateOnly),
+		Time:   now.Format(time.TimeOnly),
+		Custom: make(map[string]string, len(matchCustom)),
+	}
+	for _, kv := range matchCustom {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return "", fmt.Errorf("invalid --match-custom %q, expected key=value", kv)
+		}
+		data.Custom[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("unable to render --title-template: %w", err)
+	}
+	title := strings.TrimSpace(buf.String())
+	if title == "" {
+		return "", fmt.Errorf("--title-template rendered an empty title")
+	}
+	return title, nil
+}
+
+// ensureKey identifies the record that record ensure finds or creates.
+type ensureKey struct {
+	project           *name.Project
+	title             string
+	deviceID          string
+	customFieldValues []*commons.CustomFieldValue
+}
+
+// find returns the oldest unarchived record matching the key, or nil.
+func (k *ensureKey) find(ctx context.Context, pm *config.ProfileManager) (*openv1alpha1resource.Record, error) {
+	opts := &api.SearchRecordsOptions{
+		Project:      k.project,
+		Titles:       []string{k.title},
+		CustomFields: k.customFieldValues,
+	}
+	if k.deviceID != "" {
+		opts.DeviceIDs = []string{k.deviceID}
+	}
+	records, err := pm.RecordCli().SearchAll(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return oldestMatch(records, k), nil
+}
+
+// oldestMatch re-checks the key client side, since the title filter matches
+// keywords, and picks the oldest match so that racing invocations agree.
+func oldestMatch(records []*openv1alpha1resource.Record, k *ensureKey) *openv1alpha1resource.Record {
+	matches := lo.Filter(records, func(r *openv1alpha1resource.Record, _ int) bool {
+		if r.GetTitle() != k.title {
+			return false
+		}
+		if k.deviceID != "" && strings.TrimPrefix(r.GetDevice().GetName(), "devices/") != k.deviceID {
+			return false
+		}
+		return lo.EveryBy(k.customFieldValues, func(want *commons.CustomFieldValue) bool {
+			return lo.ContainsBy(r.GetCustomFieldValues(), func(got *commons.CustomFieldValue) bool {
+				return got.GetProperty().GetName() == want.GetProperty().GetName() && sameCustomFieldValue(got, want)
+			})
+		})
+	})
+	if len(matches) == 0 {
+		return nil
+	}
+	return slices.MinFunc(matches, func(a, b *openv1alpha1resource.Record) int {
+		if c := a.GetCreateTime().AsTime().Compare(b.GetCreateTime().AsTime()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+}
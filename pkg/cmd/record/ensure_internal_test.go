@@ -0,0 +1,59 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"testing"
+	"text/template"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRenderEnsureTitle(t *testing.T) {
+	now := time.Date(2026, 3, 1, 8, 30, 0, 0, time.Local)
+
+	tmpl := template.Must(template.New("title").Option("missingkey=error").Parse("{{.Device}}-{{.Date}}-{{.Custom.shift}}"))
+	title, err := renderEnsureTitle(tmpl, "R12", []string{"shift = night"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "R12-2026-03-01-night", title)
+
+	_, err = renderEnsureTitle(tmpl, "R12", nil, now)
+	assert.ErrorContains(t, err, "unable to render --title-template")
+
+	_, err = renderEnsureTitle(tmpl, "R12", []string{"shift"}, now)
+	assert.ErrorContains(t, err, "expected key=value")
+}
+
+func TestOldestMatch(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	record := func(name, title string, createTime time.Time) *openv1alpha1resource.Record {
+		return &openv1alpha1resource.Record{Name: name, Title: title, CreateTime: timestamppb.New(createTime)}
+	}
+	key := &ensureKey{title: "R12-2026-03-01"}
+
+	assert.Nil(t, oldestMatch([]*openv1alpha1resource.Record{record("projects/p/records/a", "R12-2026-03-01-old", t0)}, key))
+
+	got := oldestMatch([]*openv1alpha1resource.Record{
+		record("projects/p/records/b", "R12-2026-03-01", t0.Add(time.Second)),
+		record("projects/p/records/c", "R12-2026-03-01", t0),
+		record("projects/p/records/a", "R12-2026-03-01", t0),
+	}, key)
+	require.NotNil(t, got)
+	assert.Equal(t, "projects/p/records/a", got.Name)
+}
@@ -49,6 +49,9 @@ func NewFileCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.AddCommand(NewFileDeleteCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewFileCopyCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewFileMoveCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewFileMvCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewFileTreeCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewFileCopyToProjectCommand(cfgPath, io, getProvider))
 
 	return cmd
 }
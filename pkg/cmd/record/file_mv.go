@@ -0,0 +1,245 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewFileMvCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug = ""
+		force       = false
+	)
+
+	cmd := &cobra.Command{
+		Use:   "mv <record-resource-name/id> <src-path> <dst-path> [-p <working-project-slug>] [-f]",
+		Short: "Move or rename files inside a record",
+		Long: `Move or rename files inside a record.
+
+<src-path> is a file, a directory ending in "/" or a glob such as "raw/*.bag".
+A single file is renamed to <dst-path>, or moved into it if it ends in "/".
+The files of a directory or glob are moved into <dst-path>, keeping their
+path below the directory or their base name for a glob.
+
+Files are copied on the server and the originals deleted afterwards. If either
+step fails, the new copies are removed again. Existing files overwritten by the
+move cannot be rolled back, so the move asks for confirmation first unless -f
+is given.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			recordName, err := pm.RecordCli().RecordId2Name(cmd.Context(), args[0], proj)
+			if utils.IsConnectErrorWithCode(err, connect.CodeNotFound) {
+				io.Printf("failed to find record: %s in project: %s\n", args[0], proj)
+				return
+			} else if err != nil {
+				log.Fatalf("unable to get record name from %s: %v", args[0], err)
+			}
+
+			before, err := listRecordFilenames(cmd.Context(), pm.RecordCli(), recordName)
+			if err != nil {
+				log.Fatalf("failed to list files: %v", err)
+			}
+			plan, err := planFileMove(before.ToSlice(), args[1], args[2])
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
+			io.Printf("Moving %d file(s):\n", len(plan.Pairs))
+			for _, pair := range plan.Pairs {
+				io.Printf("  %s -> %s\n", pair.Src, pair.Dst)
+			}
+			if len(plan.Overwrites) > 0 && !force {
+				io.Printf("The following %d file(s) will be overwritten and cannot be restored, even if the move fails:\n", len(plan.Overwrites))
+				for _, f := range plan.Overwrites {
+					io.Printf("  - %s\n", f)
+				}
+				if confirmed := prompts.PromptYN("Do you want to continue?", io); !confirmed {
+					io.Println("Move aborted.")
+					return
+				}
+			}
+
+			if err = pm.RecordCli().CopyFilePairs(cmd.Context(), recordName, recordName, plan.Pairs); err != nil {
+				rollbackFileMove(cmd, pm, io, recordName, plan, before)
+				log.Fatalf("failed to copy files: %v", err)
+			}
+
+			srcNames := lo.Map(plan.Pairs, func(pair api.FileCopyPair, _ int) string {
+				return name.File{ProjectID: recordName.ProjectID, RecordID: recordName.RecordID, Filename: pair.Src}.String()
+			})
+			if err = pm.FileCli().BatchDeleteFiles(cmd.Context(), recordName.String(), srcNames); err != nil {
+				rollbackFileMove(cmd, pm, io, recordName, plan, before)
+				log.Fatalf("failed to delete the original files: %v", err)
+			}
+
+			io.Printf("Successfully moved %d file(s).\n", len(plan.Pairs))
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "overwrite existing files without confirmation")
+
+	return cmd
+}
+
+// fileMovePlan is the list of files to move inside a record.
+type fileMovePlan struct {
+	Pairs []api.FileCopyPair
+	// Overwrites are the destinations that already exist.
+	Overwrites []string
+}
+
+// planFileMove matches src against the existing files of a record and computes
+// where each match goes. See NewFileMvCommand for the semantics.
+func planFileMove(existing []string, src string, dst string) (*fileMovePlan, error) {
+	src = strings.TrimPrefix(src, "/")
+	dst = strings.TrimPrefix(dst, "/")
+	if src == "" || dst == "" {
+		return nil, fmt.Errorf("source and destination paths must not be empty")
+	}
+	existing = slices.Sorted(slices.Values(existing))
+	existingSet := mapset.NewSet(existing...)
+
+	var pairs []api.FileCopyPair
+	switch {
+	case strings.ContainsAny(src, "*?["):
+		if _, err := path.Match(src, ""); err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", src, err)
+		}
+		dir := strings.TrimSuffix(dst, "/") + "/"
+		for _, f := range existing {
+			if ok, _ := path.Match(src, f); ok {
+				pairs = append(pairs, api.FileCopyPair{Src: f, Dst: dir + path.Base(f)})
+			}
+		}
+	case strings.HasSuffix(src, "/"):
+		dir := strings.TrimSuffix(dst, "/") + "/"
+		for _, f := range existing {
+			if rel, ok := strings.CutPrefix(f, src); ok {
+				pairs = append(pairs, api.FileCopyPair{Src: f, Dst: dir + rel})
+			}
+		}
+	default:
+		if existingSet.Contains(src) {
+			target := dst
+			if strings.HasSuffix(dst, "/") {
+				target = dst + path.Base(src)
+			}
+			pairs = append(pairs, api.FileCopyPair{Src: src, Dst: target})
+		} else if lo.SomeBy(existing, func(f string) bool { return strings.HasPrefix(f, src+"/") }) {
+			return nil, fmt.Errorf("%s is a directory, use %s/ to move its files", src, src)
+		}
+	}
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("no files match %s", src)
+	}
+
+	plan := &fileMovePlan{Pairs: pairs}
+	srcSet := mapset.NewSet[string]()
+	dstSet := mapset.NewSet[string]()
+	for _, pair := range pairs {
+		srcSet.Add(pair.Src)
+	}
+	for _, pair := range pairs {
+		if pair.Src == pair.Dst {
+			return nil, fmt.Errorf("%s would be moved onto itself", pair.Src)
+		}
+		if srcSet.Contains(pair.Dst) {
+			return nil, fmt.Errorf("%s would overwrite %s, which is also being moved", pair.Src, pair.Dst)
+		}
+		if !dstSet.Add(pair.Dst) {
+			return nil, fmt.Errorf("several files would be moved to %s", pair.Dst)
+		}
+		if existingSet.Contains(pair.Dst) {
+			plan.Overwrites = append(plan.Overwrites, pair.Dst)
+		}
+	}
+	return plan, nil
+}
+
+// moveRollback returns the copies to delete to undo a partial move: the
+// destinations that exist now but did not before, and whose source still
+// exists. Files whose source is already gone are left moved, and overwritten
+// destinations keep the moved content, as their old content is gone.
+func moveRollback(plan *fileMovePlan, before mapset.Set[string], after mapset.Set[string]) []string {
+	var ret []string
+	for _, pair := range plan.Pairs {
+		if after.Contains(pair.Dst) && !before.Contains(pair.Dst) && after.Contains(pair.Src) {
+			ret = append(ret, pair.Dst)
+		}
+	}
+	return ret
+}
+
+func rollbackFileMove(cmd *cobra.Command, pm *config.ProfileManager, io *iostreams.IOStreams, recordName *name.Record, plan *fileMovePlan, before mapset.Set[string]) {
+	after, err := listRecordFilenames(cmd.Context(), pm.RecordCli(), recordName)
+	if err != nil {
+		log.Errorf("unable to roll back, failed to list files: %v", err)
+		return
+	}
+	copies := moveRollback(plan, before, after)
+	if len(copies) == 0 {
+		return
+	}
+	names := lo.Map(copies, func(f string, _ int) string {
+		return name.File{ProjectID: recordName.ProjectID, RecordID: recordName.RecordID, Filename: f}.String()
+	})
+	if err = pm.FileCli().BatchDeleteFiles(cmd.Context(), recordName.String(), names); err != nil {
+		log.Errorf("unable to roll back, failed to delete the copies %s: %v", strings.Join(copies, ", "), err)
+		return
+	}
+	io.Printf("Rolled back: removed %d copied file(s).\n", len(copies))
+}
+
+// listRecordFilenames returns the paths of every file in the record, nested
+// ones included, without directory markers.
+func listRecordFilenames(ctx context.Context, recordCli api.RecordInterface, recordName *name.Record) (mapset.Set[string], error) {
+	files, err := recordCli.ListAllFilesWithFilter(ctx, recordName, "recursive=\"true\"")
+	if err != nil {
+		return nil, err
+	}
+	ret := mapset.NewSet[string]()
+	for _, f := range files {
+		if !strings.HasSuffix(f.Filename, "/") {
+			ret.Add(f.Filename)
+		}
+	}
+	return ret, nil
+}
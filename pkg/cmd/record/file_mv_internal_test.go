@@ -0,0 +1,157 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"context"
+	"testing"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/name"
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanFileMove(t *testing.T) {
+	existing := []string{"raw/a.bag", "raw/b.bag", "raw/meta.json", "raw/sub/c.bag", "bags/b.bag", "notes.txt"}
+
+	tests := []struct {
+		name       string
+		src, dst   string
+		pairs      []api.FileCopyPair
+		overwrites []string
+	}{
+		{
+			name:  "rename a file",
+			src:   "notes.txt",
+			dst:   "docs/README.txt",
+			pairs: []api.FileCopyPair{{Src: "notes.txt", Dst: "docs/README.txt"}},
+		},
+		{
+			name:  "move a file into a directory",
+			src:   "/notes.txt",
+			dst:   "docs/",
+			pairs: []api.FileCopyPair{{Src: "notes.txt", Dst: "docs/notes.txt"}},
+		},
+		{
+			name: "glob keeps base names",
+			src:  "raw/*.bag",
+			dst:  "bags",
+			pairs: []api.FileCopyPair{
+				{Src: "raw/a.bag", Dst: "bags/a.bag"},
+				{Src: "raw/b.bag", Dst: "bags/b.bag"},
+			},
+			overwrites: []string{"bags/b.bag"},
+		},
+		{
+			name: "directory keeps relative paths",
+			src:  "raw/",
+			dst:  "archive/raw/",
+			pairs: []api.FileCopyPair{
+				{Src: "raw/a.bag", Dst: "archive/raw/a.bag"},
+				{Src: "raw/b.bag", Dst: "archive/raw/b.bag"},
+				{Src: "raw/meta.json", Dst: "archive/raw/meta.json"},
+				{Src: "raw/sub/c.bag", Dst: "archive/raw/sub/c.bag"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := planFileMove(existing, tt.src, tt.dst)
+			require.NoError(t, err)
+			assert.Equal(t, tt.pairs, plan.Pairs)
+			assert.Equal(t, tt.overwrites, plan.Overwrites)
+		})
+	}
+}
+
+func TestPlanFileMoveErrors(t *testing.T) {
+	existing := []string{"raw/a.bag", "raw/b.bag", "x/a.bag", "a.bag"}
+
+	tests := []struct {
+		name     string
+		src, dst string
+		err      string
+	}{
+		{"no match", "missing.bag", "b.bag", "no files match"},
+		{"no glob match", "raw/*.mcap", "out", "no files match"},
+		{"directory without slash", "raw", "out", "is a directory"},
+		{"onto itself", "a.bag", "a.bag", "onto itself"},
+		{"collision", "*/a.bag", "out", "several files would be moved to out/a.bag"},
+		{"bad glob", "raw/[.bag", "out", "invalid glob"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := planFileMove(existing, tt.src, tt.dst)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	t.Run("destination is also moved", func(t *testing.T) {
+		_, err := planFileMove([]string{"a/b", "a/a/b"}, "a/", "a/a/")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "which is also being moved")
+	})
+}
+
+func TestMoveRollback(t *testing.T) {
+	plan := &fileMovePlan{Pairs: []api.FileCopyPair{
+		{Src: "a", Dst: "x/a"},
+		{Src: "b", Dst: "x/b"},
+		{Src: "c", Dst: "x/c"},
+		{Src: "d", Dst: "x/d"},
+	}}
+	before := mapset.NewSet("a", "b", "c", "d", "x/c")
+	// a was copied, b was not, c overwrote an existing file, d was moved completely.
+	after := mapset.NewSet("a", "x/a", "b", "c", "x/c", "x/d")
+
+	assert.Equal(t, []string{"x/a"}, moveRollback(plan, before, after))
+}
+
+type fakeFileLister struct {
+	api.RecordInterface
+	filter string
+	files  []*openv1alpha1resource.File
+}
+
+func (f *fakeFileLister) ListAllFilesWithFilter(_ context.Context, _ *name.Record, filter string) ([]*openv1alpha1resource.File, error) {
+	f.filter = filter
+	return f.files, nil
+}
+
+func TestListRecordFilenames_Nested(t *testing.T) {
+	lister := &fakeFileLister{files: []*openv1alpha1resource.File{
+		{Filename: "raw/"},
+		{Filename: "raw/a.bag"},
+		{Filename: "raw/sub/"},
+		{Filename: "raw/sub/c.bag"},
+		{Filename: "notes.txt"},
+	}}
+
+	files, err := listRecordFilenames(context.Background(), lister, &name.Record{ProjectID: "p", RecordID: "r"})
+	require.NoError(t, err)
+	assert.Equal(t, `recursive="true"`, lister.filter)
+	assert.ElementsMatch(t, []string{"raw/a.bag", "raw/sub/c.bag", "notes.txt"}, files.ToSlice())
+
+	plan, err := planFileMove(files.ToSlice(), "raw/", "old/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []api.FileCopyPair{
+		{Src: "raw/a.bag", Dst: "old/a.bag"},
+		{Src: "raw/sub/c.bag", Dst: "old/sub/c.bag"},
+	}, plan.Pairs)
+}
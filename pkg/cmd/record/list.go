@@ -15,6 +15,13 @@
 package record
 
 import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
 	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	mapset "github.com/deckarep/golang-set/v2"
@@ -26,9 +33,11 @@ import (
 	"github.com/coscene-io/cocli/internal/name"
 	"github.com/coscene-io/cocli/internal/printer"
 	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
@@ -44,14 +53,52 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 		search         = ""
 		labels         []string
 		titles         []string
+		filterFlags    = &cmd_utils.RecordFilterFlags{}
+		explain        = false
+		watch          = false
+		interval       = 30 * time.Second
+		execHook       = ""
+		execParallel   = 4
+		stateFile      = ""
+		sortBy         = ""
+		desc           = false
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "list [-v] [-p <working-project-slug>] [--include-archive] [-s <search>] [--page-size <size>] [--page-token <token>] [--all] [--labels <label1,label2>] [--keywords <keyword1,keyword2>]",
+		Use:                   "list [@<saved-search>] [-v] [-p <working-project-slug>] [--include-archive] [-s <search> [--explain]] [--page-size <size>] [--page-token <token>] [--all] [--sort-by <field> [--desc]] [--labels <label1,label2>] [--keywords <keyword1,keyword2>] [--created-after <time>] [--created-before <time>] [--updated-since <time>] [--creator <nickname>] [--device <device>] [--custom <key=value>...] [--has-file <glob>...] [--watch [--interval <duration>] [--exec <command>] [--exec-concurrency <n>] [--state-file <path>]]",
 		Short:                 "List records in a project",
 		DisableFlagsInUseLine: true,
-		Args:                  cobra.ExactArgs(0),
+		Args:                  savedSearchArgs,
 		Run: func(cmd *cobra.Command, args []string) {
+			ref, err := cmd_utils.SavedSearchRef(cmd, args, search)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
+			if !watch {
+				for _, f := range watchFlagNames {
+					if cmd.Flags().Changed(f) {
+						log.Fatalf("--%s requires --watch", f)
+					}
+				}
+			} else if outputFormat != "table" && outputFormat != "wide" && outputFormat != "ndjson" {
+				log.Fatalf("--watch supports table, wide and ndjson output, got %q", outputFormat)
+			}
+
+			order, err := utils.ParseSortOrder(sortBy, desc, recordSortFields)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+			// SearchRecords cannot order by size, so it is sorted client side,
+			// which is only correct over the complete result.
+			serverOrder := order
+			if order.Field == utils.SortSize {
+				if !all {
+					log.Fatalf("--sort-by %s requires --all", utils.SortSize)
+				}
+				serverOrder = utils.SortOrder{}
+			}
+
 			// Validate pagination flags
 			if pageSize > 0 && (pageSize < 10 || pageSize > 100) {
 				log.Fatalf("--page-size must be between 10 and 100")
@@ -70,10 +117,85 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 			searchOptions := &api.SearchRecordsOptions{
 				Project:        proj,
 				IncludeArchive: includeArchive,
-				Search:         search,
 				Labels:         labels,
 				Titles:         titles,
-				OrderBy:        "",
+				OrderBy:        serverOrder.OrderBy(),
+			}
+			if err = cmd_utils.ResolveSelection(cmd.Context(), pm, proj, ref, search, filterFlags, searchOptions); err != nil {
+				log.Fatalf("%v", err)
+			}
+
+			if explain {
+				if searchOptions.Search == "" {
+					log.Fatalf("--explain requires a search query")
+				}
+				q, err := cmd_utils.CompileSearch(cmd.Context(), pm, proj, searchOptions.Search, searchOptions.IncludeArchive)
+				if err != nil {
+					log.Fatalf("%v", err)
+				}
+				explained, err := cmd_utils.ExplainSearch(q)
+				if err != nil {
+					log.Fatalf("unable to render search: %v", err)
+				}
+				io.Println(explained)
+				return
+			}
+
+			if watch {
+				if interval < time.Second {
+					log.Fatalf("--interval must be at least 1s")
+				}
+				if execParallel < 1 {
+					log.Fatalf("--exec-concurrency must be at least 1")
+				}
+				w := &recordWatcher{
+					pm:              pm,
+					io:              io,
+					options:         searchOptions,
+					statePath:       stateFile,
+					interval:        interval,
+					hookConcurrency: execParallel,
+				}
+				if w.statePath == "" {
+					w.statePath = defaultWatchStatePath(fmt.Sprintf("%s\x00%s\x00%s\x00%v\x00%v\x00%v\x00%+v", proj, ref, search, labels, titles, includeArchive, *filterFlags))
+				}
+				if execHook != "" {
+					if w.hook, err = parseExecHook(execHook); err != nil {
+						log.Fatalf("invalid --exec template: %v", err)
+					}
+				}
+				if outputFormat == "ndjson" {
+					w.print = func(records []*openv1alpha1resource.Record) error {
+						return printRecordsNDJSON(records, io)
+					}
+				} else {
+					var omitFields []string
+					if !searchOptions.IncludeArchive {
+						omitFields = append(omitFields, "ARCHIVED")
+					}
+					format, tableOpts := recordTableOpts(verbose, outputFormat, omitFields)
+					p, err := printer.Printer(format, &printer.Options{TableOpts: tableOpts})
+					if err != nil {
+						log.Fatal(err)
+					}
+					w.print = func(records []*openv1alpha1resource.Record) error {
+						var userNames map[string]string
+						if outputFormat == "wide" {
+							userNames = resolveUserNames(cmd, pm, records)
+						}
+						if err := p.PrintObj(printable.NewRecordWithUserNames(records, "", userNames), io.Out); err != nil {
+							return err
+						}
+						tableOpts.NoHeader = true
+						return nil
+					}
+				}
+
+				io.Eprintf("Watching for new records every %s, press Ctrl+C to stop.\n", interval)
+				if err = w.run(cmd.Context()); err != nil {
+					log.Fatalf("%v", err)
+				}
+				return
 			}
 
 			if all {
@@ -81,6 +203,7 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 				if err != nil {
 					log.Fatalf("unable to search records: %v", err)
 				}
+				utils.SortBy(records, order, recordCompare)
 			} else if page > 1 {
 				io.Eprintf("Warning: --page is deprecated due to backend changes. Use --page-token for pagination.\n")
 				io.Eprintf("Note: Fetching pages 1-%d sequentially (this may be slow)...\n\n", page)
@@ -155,7 +278,7 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 			}
 
 			var omitFields []string
-			if !includeArchive {
+			if !searchOptions.IncludeArchive {
 				omitFields = append(omitFields, "ARCHIVED")
 			}
 
@@ -207,14 +330,22 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
 	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
 	cmd.Flags().BoolVar(&includeArchive, "include-archive", false, "include archived records")
-	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table|wide|csv|json|yaml)")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "output format (table|wide|csv|json|yaml, or ndjson with --watch)")
 	cmd.Flags().IntVar(&pageSize, "page-size", 0, "number of records per page (10-100)")
 	cmd.Flags().IntVar(&page, "page", 1, "[DEPRECATED] page number (use --page-token instead)")
 	cmd.Flags().StringVar(&pageToken, "page-token", "", "page token for pagination (get from previous response)")
 	cmd.Flags().BoolVar(&all, "all", false, "list all records (overrides pagination)")
-	cmd.Flags().StringVarP(&search, "search", "s", "", "JSON Logic search query (from frontend advanced search)")
+	cmd.Flags().StringVar(&sortBy, "sort-by", "", "sort records by field ("+strings.Join(recordSortFields, "|")+"), size requires --all")
+	cmd.Flags().BoolVar(&desc, "desc", false, "sort in descending order, requires --sort-by")
+	cmd.Flags().StringVarP(&search, "search", "s", "", `search query, e.g. 'labels:"night" AND created>2026-01-01', JSON Logic (from frontend advanced search) or @<saved-search>`)
+	cmd.Flags().BoolVar(&explain, "explain", false, "print the JSON Logic generated for --search instead of listing records")
 	cmd.Flags().StringSliceVar(&labels, "labels", []string{}, "filter by labels (comma-separated)")
 	cmd.Flags().StringSliceVar(&titles, "keywords", []string{}, "filter by keywords in titles (comma-separated)")
+	cmd.Flags().BoolVar(&watch, "watch", false, "keep polling and print only records created since the last poll")
+	cmd.Flags().DurationVar(&interval, "interval", interval, "how often to poll with --watch")
+	cmd.Flags().StringVar(&execHook, "exec", "", "shell command run for each new record, as a Go template over the record, e.g. 'script {{.Name}}'; values are shell-quoted, also inside quotes")
+	cmd.Flags().IntVar(&execParallel, "exec-concurrency", execParallel, "maximum number of --exec commands running at once")
+	cmd.Flags().StringVar(&stateFile, "state-file", "", "file keeping the --watch high-water mark (default: per selection under ~/.cache/cocli/watch)")
 
 	cmd.MarkFlagsMutuallyExclusive("all", "page-size")
 	cmd.MarkFlagsMutuallyExclusive("all", "page")
@@ -223,10 +354,44 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.MarkFlagsMutuallyExclusive("search", "include-archive")
 	cmd.MarkFlagsMutuallyExclusive("search", "labels")
 	cmd.MarkFlagsMutuallyExclusive("search", "keywords")
+	for _, f := range []string{"all", "page", "page-size", "page-token", "explain", "sort-by", "desc"} {
+		cmd.MarkFlagsMutuallyExclusive("watch", f)
+	}
+	filterFlags.Register(cmd)
 
 	return cmd
 }
 
+// recordSortFields are the --sort-by fields of record list. SearchRecords
+// orders by all but size, which recordCompare sorts client side.
+var recordSortFields = []string{utils.SortCreateTime, utils.SortUpdateTime, utils.SortTitle, utils.SortSize}
+
+var recordCompare = map[string]func(a, b *openv1alpha1resource.Record) int{
+	utils.SortSize: func(a, b *openv1alpha1resource.Record) int {
+		return cmp.Compare(a.GetByteSize(), b.GetByteSize())
+	},
+}
+
+// watchFlagNames are the flags only meaningful with --watch.
+var watchFlagNames = []string{"interval", "exec", "exec-concurrency", "state-file"}
+
+// printRecordsNDJSON prints one compact JSON record per line.
+func printRecordsNDJSON(records []*openv1alpha1resource.Record, io *iostreams.IOStreams) error {
+	for _, r := range records {
+		raw, err := protojson.Marshal(r)
+		if err != nil {
+			return err
+		}
+		// protojson randomizes whitespace, compact it for stable lines.
+		var buf bytes.Buffer
+		if err = json.Compact(&buf, raw); err != nil {
+			return err
+		}
+		io.Println(buf.String())
+	}
+	return nil
+}
+
 func resolveUserNames(cmd *cobra.Command, pm *config.ProfileManager, records []*openv1alpha1resource.Record) map[string]string {
 	userNameSet := mapset.NewSet[name.User]()
 	for _, r := range records {
@@ -20,13 +20,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"time"
+	"strings"
 
 	openv1alpha1enum "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/enums"
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	"connectrpc.com/connect"
 	"github.com/coscene-io/cocli/internal/config"
 	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
 	"github.com/coscene-io/cocli/internal/printer"
 	"github.com/coscene-io/cocli/internal/printer/printable"
 	"github.com/coscene-io/cocli/internal/printer/table"
@@ -47,6 +48,10 @@ func NewMomentCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 	cmd.AddCommand(NewMomentCreateCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewMomentListCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewMomentDownloadCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewMomentImportCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewMomentUpdateCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewMomentDeleteCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewMomentExtractCommand(cfgPath, io, getProvider))
 
 	return cmd
 }
@@ -55,11 +60,13 @@ func NewMomentCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvide
 	var (
 		projectSlug                           = ""
 		displayName                           = ""
-		durationRaw                           = float64(0)
+		durationRaw                           = ""
 		customizedFieldsRaw                   = ""
 		customizedFields    map[string]string = nil
 		description                           = ""
-		triggerTime                           = float64(0)
+		triggerTimeRaw                        = ""
+		at                                    = ""
+		timeFlags                             = momentTimeFlags{}
 		assigner                              = ""
 		assignee                              = ""
 		skipCreateTask                        = false
@@ -68,8 +75,15 @@ func NewMomentCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvide
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "create <record-resource-name/id> [-p <working-project-slug>]",
-		Short:                 "Create a moment in a record",
+		Use:   "create <record-resource-name/id> [-p <working-project-slug>] -n <display-name> (-T <trigger-time> | --at <offset>) [-D <duration>]",
+		Short: "Create a moment in a record",
+		Long: `Create a moment in a record.
+
+--trigger-time takes RFC3339 (2025-01-01T10:00:00.5Z), a datetime in --tz
+(2025-01-01 10:00:00.5) or unix seconds (1735725600.5). --at takes an offset
+from the start of the record, e.g. +00:12:03.5, +12:03 or +723.5s, where the
+start is the first message of its earliest MCAP file, or of --relative-to.
+--duration takes seconds (1.5), a duration (1m30s) or a clock (00:01:30).`,
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
@@ -89,17 +103,29 @@ func NewMomentCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvide
 			if err = json.Unmarshal([]byte(customizedFieldsRaw), &customizedFields); err != nil {
 				log.Fatalf("unable to unmarshal customized fields: %v", err)
 			}
-			duration, err := time.ParseDuration(fmt.Sprintf("%fs", durationRaw))
+			duration, err := utils.ParseTimeSpan(durationRaw)
 			if err != nil {
 				log.Fatalf("unable to parse duration: %v", err)
 			}
+			parser, err := timeFlags.parser(cmd.Context(), pm, recordName)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if at != "" {
+				// --at is always an offset, the sign is optional.
+				triggerTimeRaw = at
+				if !strings.HasPrefix(at, "+") && !strings.HasPrefix(at, "-") {
+					triggerTimeRaw = "+" + at
+				}
+			}
+			triggerTime, err := parser.Parse(triggerTimeRaw)
+			if err != nil {
+				log.Fatalf("unable to parse trigger time: %v", err)
+			}
 
 			eventToCreate := &openv1alpha1resource.Event{
-				DisplayName: displayName,
-				TriggerTime: timestamppb.New(time.Unix(
-					int64(triggerTime),
-					int64((triggerTime-float64(int64(triggerTime)))*1e9),
-				)),
+				DisplayName:      displayName,
+				TriggerTime:      timestamppb.New(triggerTime),
 				Duration:         durationpb.New(duration),
 				Description:      description,
 				CustomizedFields: customizedFields,
@@ -127,80 +153,9 @@ func NewMomentCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvide
 				return
 			}
 
-			taskDescription, _ := json.Marshal(map[string]interface{}{
-				"root": map[string]interface{}{
-					"children": []map[string]interface{}{
-						{
-							"children": []map[string]interface{}{
-								{
-									"mode":    "normal",
-									"text":    fmt.Sprintln(description),
-									"type":    "text",
-									"version": 1,
-								},
-							},
-							"indent":    0,
-							"direction": "ltr",
-							"format":    "",
-							"type":      "paragraph",
-							"version":   1,
-						},
-						{
-							"children": []map[string]interface{}{
-								{
-									"sourceName": obtainEventRes.GetEvent().GetName(),
-									"sourceType": "moment",
-									"type":       "source",
-									"version":    1,
-								},
-							},
-							"direction": nil,
-							"format":    "",
-							"indent":    0,
-							"type":      "paragraph",
-							"version":   1,
-						},
-					},
-					"direction": nil,
-					"indent":    0,
-					"format":    "",
-					"type":      "root",
-					"version":   1,
-				},
-			})
-
-			upsertTaskRes, err := pm.TaskCli().UpsertTask(
-				context.Background(),
-				recordName.Project().String(),
-				&openv1alpha1resource.Task{
-					Title:       displayName,
-					Description: string(taskDescription),
-					Creator:     assigner,
-					Assigner:    assigner,
-					Assignee:    assignee,
-					Category:    openv1alpha1enum.TaskCategoryEnum_COMMON,
-					State:       openv1alpha1enum.TaskStateEnum_PROCESSING,
-					Detail: &openv1alpha1resource.Task_CommonTaskDetail{CommonTaskDetail: &openv1alpha1resource.CommonTaskDetail{
-						Related: &openv1alpha1resource.CommonTaskDetail_Event{
-							Event: obtainEventRes.GetEvent().GetName(),
-						},
-					}},
-				},
-			)
-			if err != nil {
-				log.Fatalf("failed to upsert task: %v", err)
-			}
-
-			log.Infof("upserted task: %s", upsertTaskRes.Name)
-
-			if syncTask {
-				syncTaskRes, err := pm.TaskCli().SyncTask(context.Background(), upsertTaskRes.Name)
-				if err != nil {
-					log.Fatalf("failed to sync task: %v", err)
-				}
-				log.Infof("synced task: %s", syncTaskRes.Name)
+			if err = upsertMomentTask(context.Background(), pm, recordName, obtainEventRes.GetEvent().GetName(), displayName, description, assigner, assignee, syncTask); err != nil {
+				log.Fatal(err)
 			}
-
 		},
 	}
 
@@ -208,17 +163,21 @@ func NewMomentCreateCommand(cfgPath *string, io *iostreams.IOStreams, getProvide
 	cmd.Flags().StringVarP(&displayName, "display-name", "n", "", "The name of the moment.")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "The description of the moment.")
 	cmd.Flags().StringVarP(&customizedFieldsRaw, "customized-fields", "j", "{}", "The customized fields of the moment.")
-	cmd.Flags().Float64VarP(&triggerTime, "trigger-time", "T", 0, "trigger time in seconds.")
-	cmd.Flags().Float64VarP(&durationRaw, "duration", "D", 1, "The duration of the moment in seconds.")
+	cmd.Flags().StringVarP(&triggerTimeRaw, "trigger-time", "T", "", "The trigger time, RFC3339, datetime or unix seconds.")
+	cmd.Flags().StringVar(&at, "at", "", "The trigger time as an offset from the record start, e.g. +00:12:03.5.")
+	cmd.Flags().StringVarP(&durationRaw, "duration", "D", "1", "The duration of the moment, seconds, a duration or a clock.")
 	cmd.Flags().StringVarP(&assigner, "assigner", "a", "", "The assigner of task.")
 	cmd.Flags().StringVarP(&assignee, "assignee", "e", "", "The assignee of task.")
 	cmd.Flags().StringVarP(&ruleName, "rule-name", "R", "", "The name of the rule to create moment.")
 	cmd.Flags().BoolVarP(&skipCreateTask, "skip-create-task", "s", false, "Create task or not.")
 	cmd.Flags().BoolVarP(&syncTask, "sync-task", "S", false, "Sync task or not.")
 
+	timeFlags.register(cmd)
+
 	_ = cmd.MarkFlagRequired("display-name")
 	_ = cmd.MarkFlagRequired("duration")
-	_ = cmd.MarkFlagRequired("trigger-time")
+	cmd.MarkFlagsOneRequired("trigger-time", "at")
+	cmd.MarkFlagsMutuallyExclusive("trigger-time", "at")
 	return cmd
 }
 
@@ -346,3 +305,81 @@ func NewMomentDownloadCommand(cfgPath *string, io *iostreams.IOStreams, getProvi
 
 	return cmd
 }
+
+// upsertMomentTask creates a task titled after the moment, optionally syncing it.
+func upsertMomentTask(ctx context.Context, pm *config.ProfileManager, recordName *name.Record, eventName string, title string, description string, assigner string, assignee string, syncTask bool) error {
+	taskDescription, _ := json.Marshal(map[string]interface{}{
+		"root": map[string]interface{}{
+			"children": []map[string]interface{}{
+				{
+					"children": []map[string]interface{}{
+						{
+							"mode":    "normal",
+							"text":    fmt.Sprintln(description),
+							"type":    "text",
+							"version": 1,
+						},
+					},
+					"indent":    0,
+					"direction": "ltr",
+					"format":    "",
+					"type":      "paragraph",
+					"version":   1,
+				},
+				{
+					"children": []map[string]interface{}{
+						{
+							"sourceName": eventName,
+							"sourceType": "moment",
+							"type":       "source",
+							"version":    1,
+						},
+					},
+					"direction": nil,
+					"format":    "",
+					"indent":    0,
+					"type":      "paragraph",
+					"version":   1,
+				},
+			},
+			"direction": nil,
+			"indent":    0,
+			"format":    "",
+			"type":      "root",
+			"version":   1,
+		},
+	})
+
+	upsertTaskRes, err := pm.TaskCli().UpsertTask(
+		ctx,
+		recordName.Project().String(),
+		&openv1alpha1resource.Task{
+			Title:       title,
+			Description: string(taskDescription),
+			Creator:     assigner,
+			Assigner:    assigner,
+			Assignee:    assignee,
+			Category:    openv1alpha1enum.TaskCategoryEnum_COMMON,
+			State:       openv1alpha1enum.TaskStateEnum_PROCESSING,
+			Detail: &openv1alpha1resource.Task_CommonTaskDetail{CommonTaskDetail: &openv1alpha1resource.CommonTaskDetail{
+				Related: &openv1alpha1resource.CommonTaskDetail_Event{
+					Event: eventName,
+				},
+			}},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert task: %w", err)
+	}
+
+	log.Infof("upserted task: %s", upsertTaskRes.Name)
+
+	if syncTask {
+		syncTaskRes, err := pm.TaskCli().SyncTask(ctx, upsertTaskRes.Name)
+		if err != nil {
+			return fmt.Errorf("failed to sync task: %w", err)
+		}
+		log.Infof("synced task: %s", syncTaskRes.Name)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewMomentUpdateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug         = ""
+		displayName         = ""
+		description         = ""
+		triggerTime         = ""
+		duration            = ""
+		tz                  = ""
+		customizedFieldsRaw = ""
+		outputFormat        = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "update <moment-resource-name/id> [-p <working-project-slug>] [-n <display-name>] [-d <description>] [-T <trigger-time>] [--tz <zone>] [-D <duration>] [-j <customized-fields>]",
+		Short:                 "Update a moment",
+		Long:                  "Update a moment. Only the given fields are changed. The moment id is shown by \"record moment list\".",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+			eventName := momentName(args[0], proj)
+
+			event := &openv1alpha1resource.Event{Name: eventName.String()}
+			var paths []string
+			if cmd.Flags().Changed("display-name") {
+				if displayName == "" {
+					log.Fatalf("--display-name must not be empty")
+				}
+				event.DisplayName = displayName
+				paths = append(paths, "display_name")
+			}
+			if cmd.Flags().Changed("description") {
+				event.Description = description
+				paths = append(paths, "description")
+			}
+			if cmd.Flags().Changed("trigger-time") {
+				loc, err := momentLocation(tz)
+				if err != nil {
+					log.Fatal(err)
+				}
+				trigger, err := utils.TimeParser{Location: loc}.Parse(triggerTime)
+				if err != nil {
+					log.Fatalf("unable to parse trigger time: %v", err)
+				}
+				event.TriggerTime = timestamppb.New(trigger)
+				paths = append(paths, "trigger_time")
+			}
+			if cmd.Flags().Changed("duration") {
+				d, err := utils.ParseTimeSpan(duration)
+				if err != nil {
+					log.Fatalf("unable to parse duration: %v", err)
+				}
+				event.Duration = durationpb.New(d)
+				paths = append(paths, "duration")
+			}
+			if cmd.Flags().Changed("customized-fields") {
+				if err = json.Unmarshal([]byte(customizedFieldsRaw), &event.CustomizedFields); err != nil {
+					log.Fatalf("unable to unmarshal customized fields: %v", err)
+				}
+				paths = append(paths, "customized_fields")
+			}
+			if len(paths) == 0 {
+				log.Fatalf("nothing to update, specify at least one field to change")
+			}
+
+			updated, err := pm.EventCli().UpdateEvent(cmd.Context(), event, paths)
+			if utils.IsConnectErrorWithCode(err, connect.CodeNotFound) {
+				io.Printf("failed to find moment: %s\n", eventName)
+				return
+			} else if err != nil {
+				log.Fatalf("failed to update moment: %v", err)
+			}
+
+			p, err := printer.Printer(outputFormat, &printer.Options{TableOpts: &table.PrintOpts{}})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err = p.PrintObj(printable.NewEvent([]*openv1alpha1resource.Event{updated}), io.Out); err != nil {
+				log.Fatalf("unable to print moment: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().StringVarP(&displayName, "display-name", "n", "", "new name of the moment")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "new description of the moment")
+	cmd.Flags().StringVarP(&triggerTime, "trigger-time", "T", "", "new trigger time, e.g. 2024-05-01T08:00:00Z, 2024-05-01 08:00:00 or unix seconds")
+	cmd.Flags().StringVarP(&duration, "duration", "D", "", "new duration, e.g. 1m30s, 00:01:30 or seconds")
+	cmd.Flags().StringVar(&tz, "tz", "", momentTzUsage)
+	cmd.Flags().StringVarP(&customizedFieldsRaw, "customized-fields", "j", "", "new customized fields as a JSON object, replacing the existing ones")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|json|yaml)")
+
+	return cmd
+}
+
+func NewMomentDeleteCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug = ""
+		all         = false
+		namePattern = ""
+		attributes  map[string]string
+		parallel    = 0
+		force       = false
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete <record-resource-name/id> [<moment-resource-name/id>...] [-p <working-project-slug>] [--all] [--name <pattern>] [--attribute <key=value>] [-f]",
+		Short: "Delete moments of a record",
+		Long: `Delete moments of a record, either the given moments, all of them with --all,
+or those matching --name and --attribute. --name is a glob on the moment name,
+e.g. "lidar*". Filters also narrow down the given moments.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			recordName, err := pm.RecordCli().RecordId2Name(cmd.Context(), args[0], proj)
+			if utils.IsConnectErrorWithCode(err, connect.CodeNotFound) {
+				io.Printf("failed to find record: %s in project: %s\n", args[0], proj)
+				return
+			} else if err != nil {
+				log.Fatalf("unable to get record name from %s: %v", args[0], err)
+			}
+
+			events, err := pm.RecordCli().ListAllEvents(cmd.Context(), recordName)
+			if err != nil {
+				log.Fatalf("unable to list moments: %v", err)
+			}
+
+			toDelete, err := selectMoments(events, args[1:], all, namePattern, attributes)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if len(toDelete) == 0 {
+				io.Println("No moments matched.")
+				return
+			}
+
+			if !force {
+				io.Printf("About to delete %d moment(s) from record %s:\n", len(toDelete), recordName.RecordID)
+				for _, e := range toDelete {
+					io.Printf("  - %s (%s)\n", e.DisplayName, e.TriggerTime.AsTime().In(time.Local).Format(time.RFC3339))
+				}
+				if confirmed := prompts.PromptYN("Do you want to continue?", io); !confirmed {
+					io.Println("Delete aborted.")
+					return
+				}
+			}
+
+			errs := utils.ParallelFor(toDelete, parallel, func(_ int, e *openv1alpha1resource.Event) error {
+				eventName, err := name.NewEvent(e.Name)
+				if err != nil {
+					return err
+				}
+				return pm.EventCli().DeleteEvent(cmd.Context(), eventName)
+			})
+			failed := 0
+			for i, err := range errs {
+				if err != nil {
+					failed++
+					log.Errorf("failed to delete moment %q: %v", toDelete[i].DisplayName, err)
+				}
+			}
+			if failed > 0 {
+				log.Fatalf("failed to delete %d of %d moments", failed, len(toDelete))
+			}
+			io.Printf("Successfully deleted %d moment(s).\n", len(toDelete))
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().BoolVar(&all, "all", false, "delete all moments of the record")
+	cmd.Flags().StringVar(&namePattern, "name", "", "only delete moments whose name matches this glob")
+	cmd.Flags().StringToStringVar(&attributes, "attribute", nil, "only delete moments with this customized field value, e.g. source=lidar")
+	cmd.Flags().IntVar(&parallel, "parallel", 4, "number of moments deleted in parallel")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "delete without confirmation")
+
+	cmd.MarkFlagsMutuallyExclusive("all", "name")
+	cmd.MarkFlagsMutuallyExclusive("all", "attribute")
+
+	return cmd
+}
+
+// momentName parses a moment resource name, or builds it from an id of proj.
+func momentName(arg string, proj *name.Project) *name.Event {
+	if eventName, err := name.NewEvent(arg); err == nil {
+		return eventName
+	}
+	return &name.Event{ProjectID: proj.ProjectID, EventID: arg}
+}
+
+// selectMoments picks the events to delete: all of them, the ones given by
+// name or id, and/or the ones matching the name glob and attributes.
+func selectMoments(events []*openv1alpha1resource.Event, ids []string, all bool, namePattern string, attributes map[string]string) ([]*openv1alpha1resource.Event, error) {
+	if all {
+		if len(ids) > 0 {
+			return nil, errors.New("--all cannot be combined with moment ids")
+		}
+		return events, nil
+	}
+	if len(ids) == 0 && namePattern == "" && len(attributes) == 0 {
+		return nil, errors.New("specify moments to delete, --all, --name or --attribute")
+	}
+	if namePattern != "" {
+		if _, err := path.Match(namePattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid --name pattern %q: %w", namePattern, err)
+		}
+	}
+
+	candidates := events
+	if len(ids) > 0 {
+		candidates = nil
+		for _, id := range ids {
+			found := false
+			for _, e := range events {
+				if e.Name == id || strings.HasSuffix(e.Name, "/events/"+id) {
+					candidates = append(candidates, e)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("moment %s not found in the record", id)
+			}
+		}
+	}
+
+	var selected []*openv1alpha1resource.Event
+	for _, e := range candidates {
+		if namePattern != "" {
+			if ok, _ := path.Match(namePattern, e.DisplayName); !ok {
+				continue
+			}
+		}
+		matched := true
+		for k, v := range attributes {
+			if e.CustomizedFields[k] != v {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			selected = append(selected, e)
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"testing"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMomentName(t *testing.T) {
+	proj := &name.Project{ProjectID: "p1"}
+	assert.Equal(t, "projects/p1/events/e1", momentName("e1", proj).String())
+	assert.Equal(t, "projects/p2/events/e2", momentName("projects/p2/events/e2", proj).String())
+}
+
+func TestSelectMoments(t *testing.T) {
+	events := []*openv1alpha1resource.Event{
+		{Name: "projects/p1/events/e1", DisplayName: "lidar dropout", CustomizedFields: map[string]string{"source": "detector"}},
+		{Name: "projects/p1/events/e2", DisplayName: "lidar glitch", CustomizedFields: map[string]string{"source": "manual"}},
+		{Name: "projects/p1/events/e3", DisplayName: "hard brake", CustomizedFields: map[string]string{"source": "detector"}},
+	}
+	ids := func(selected []*openv1alpha1resource.Event) []string {
+		return lo.Map(selected, func(e *openv1alpha1resource.Event, _ int) string { return e.Name })
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		all         bool
+		namePattern string
+		attributes  map[string]string
+		want        []string
+		wantErr     string
+	}{
+		{name: "all", all: true, want: []string{"projects/p1/events/e1", "projects/p1/events/e2", "projects/p1/events/e3"}},
+		{name: "by id and resource name", args: []string{"e3", "projects/p1/events/e1"}, want: []string{"projects/p1/events/e3", "projects/p1/events/e1"}},
+		{name: "by name glob", namePattern: "lidar*", want: []string{"projects/p1/events/e1", "projects/p1/events/e2"}},
+		{name: "by attribute", attributes: map[string]string{"source": "detector"}, want: []string{"projects/p1/events/e1", "projects/p1/events/e3"}},
+		{name: "filters narrow ids", args: []string{"e1", "e2"}, attributes: map[string]string{"source": "manual"}, want: []string{"projects/p1/events/e2"}},
+		{name: "no match", namePattern: "camera*", want: []string{}},
+		{name: "unknown id", args: []string{"e9"}, wantErr: "moment e9 not found"},
+		{name: "nothing selected", wantErr: "specify moments to delete"},
+		{name: "all with ids", args: []string{"e1"}, all: true, wantErr: "--all cannot be combined"},
+		{name: "bad pattern", namePattern: "[", wantErr: "invalid --name pattern"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := selectMoments(events, tt.args, tt.all, tt.namePattern, tt.attributes)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(selected))
+		})
+	}
+}
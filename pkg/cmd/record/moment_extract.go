@@ -0,0 +1,467 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/mcap"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/printer/table"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewMomentExtractCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug  = ""
+		recordArg    = ""
+		files        []string
+		topics       []string
+		fields       = momentFields{title: "name"}
+		tz           = ""
+		parallel     = 4
+		dryRun       = false
+		outputFormat = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:   "extract <record-resource-name/id | local.mcap> --topic <topic> [-p <working-project-slug>] [--record <record-resource-name/id>] [--file <path>] [--title-field <field>] [--time-field <field>] [--duration-field <field>] [--description-field <field>] [--tz <zone>] [--dry-run]",
+		Short: "Create moments from the messages of MCAP files",
+		Long: `Create a moment for every message of the given topics in MCAP files, either
+the MCAP files of a record or a local file. Moments from a local file are
+created in the record given by --record.
+
+Messages encoded as json, protobuf, ros1 or cdr (ROS 2) are supported. Fields
+are addressed by dotted paths, e.g. header.stamp or status.0.name. Times may be
+{sec, nsec} or {sec, nanosec} structs, unix seconds or time strings, and
+default to the log time of the message. Durations may be {sec, nsec} structs,
+seconds or strings such as 1m30s, and default to 1s. Messages without the
+title field are skipped.
+
+Moments that already exist are left untouched, so an extraction can be re-run
+safely. Use --dry-run to preview the moments without creating them.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			localPath := ""
+			if info, err := os.Stat(args[0]); err == nil && !info.IsDir() {
+				localPath = args[0]
+				if len(files) > 0 {
+					log.Fatalf("--file only applies to records, not to local files")
+				}
+				if recordArg == "" && !dryRun {
+					log.Fatalf("--record is required to create moments from a local file")
+				}
+			} else {
+				if recordArg != "" {
+					log.Fatalf("--record only applies to local files")
+				}
+				recordArg = args[0]
+			}
+
+			var err error
+			if fields.loc, err = momentLocation(tz); err != nil {
+				log.Fatal(err)
+			}
+
+			var (
+				pm         *config.ProfileManager
+				recordName *name.Record
+			)
+			if recordArg != "" {
+				pm = cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+				proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+				if err != nil {
+					log.Fatalf("unable to get project name: %v", err)
+				}
+				recordName, err = pm.RecordCli().RecordId2Name(cmd.Context(), recordArg, proj)
+				if utils.IsConnectErrorWithCode(err, connect.CodeNotFound) {
+					io.Printf("failed to find record: %s in project: %s\n", recordArg, proj)
+					return
+				} else if err != nil {
+					log.Fatalf("unable to get record name from %s: %v", recordArg, err)
+				}
+			}
+
+			var sources []momentSource
+			if localPath != "" {
+				sources = append(sources, localMcapSource(localPath))
+			} else if sources, err = recordMcapSources(cmd.Context(), pm, recordName, files); err != nil {
+				log.Fatalf("unable to list MCAP files: %v", err)
+			}
+
+			var (
+				events []*openv1alpha1resource.Event
+				stats  momentExtractStats
+			)
+			for _, src := range sources {
+				extracted, err := src.extract(cmd.Context(), topics, &fields, &stats)
+				if err != nil {
+					log.Fatalf("unable to extract moments from %s: %v", src.filename, err)
+				}
+				events = append(events, extracted...)
+			}
+			if recordName != nil {
+				for _, e := range events {
+					e.Record = recordName.String()
+				}
+			}
+
+			if stats.messages == 0 {
+				io.Printf("No messages on %s in %d MCAP files.\n", strings.Join(topics, ", "), len(sources))
+				return
+			}
+			if stats.skipped > 0 {
+				io.Eprintf("Skipped %d of %d messages without a %q field.\n", stats.skipped, stats.messages, fields.title)
+			}
+
+			if dryRun {
+				p, err := printer.Printer(outputFormat, &printer.Options{TableOpts: &table.PrintOpts{}})
+				if err != nil {
+					log.Fatal(err)
+				}
+				if err = p.PrintObj(printable.NewEvent(events), io.Out); err != nil {
+					log.Fatalf("unable to print moments: %v", err)
+				}
+				io.Eprintf("Dry run: %d moments would be created from %d MCAP files.\n", len(events), len(sources))
+				return
+			}
+
+			var created, existed atomic.Int64
+			errs := utils.ParallelFor(events, parallel, func(_ int, e *openv1alpha1resource.Event) error {
+				res, err := pm.EventCli().ObtainEvent(cmd.Context(), recordName.Project().String(), e)
+				if err != nil {
+					return err
+				}
+				if res.GetIsNew() {
+					created.Add(1)
+				} else {
+					existed.Add(1)
+				}
+				return nil
+			})
+
+			failed := 0
+			for i, err := range errs {
+				if err != nil {
+					failed++
+					log.Errorf("moment %q at %s: %v", events[i].DisplayName, events[i].TriggerTime.AsTime().In(time.Local).Format(time.RFC3339Nano), err)
+				}
+			}
+
+			io.Printf("Extracted %d moments from %d MCAP files to %s: %d created, %d already existed, %d failed.\n",
+				len(events), len(sources), recordName.RecordID, created.Load(), existed.Load(), failed)
+			if failed > 0 {
+				log.Fatalf("failed to create %d moments", failed)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().StringVar(&recordArg, "record", "", "the record to create moments in, when extracting from a local file")
+	cmd.Flags().StringArrayVar(&files, "file", []string{}, "only extract from this MCAP file of the record (repeatable, default: all MCAP files)")
+	cmd.Flags().StringSliceVar(&topics, "topic", []string{}, "topics whose messages become moments (comma-separated)")
+	cmd.Flags().StringVar(&fields.title, "title-field", fields.title, "field holding the name of the moment")
+	cmd.Flags().StringVar(&fields.time, "time-field", "", "field holding the trigger time (default: the log time of the message)")
+	cmd.Flags().StringVar(&fields.duration, "duration-field", "", "field holding the duration (default: 1s)")
+	cmd.Flags().StringVar(&fields.description, "description-field", "", "field holding the description")
+	cmd.Flags().StringVar(&tz, "tz", "", momentTzUsage)
+	cmd.Flags().IntVar(&parallel, "parallel", parallel, "number of moments created in parallel")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the moments instead of creating them")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format of --dry-run (table|json|yaml|csv)")
+
+	_ = cmd.MarkFlagRequired("topic")
+
+	return cmd
+}
+
+// momentSource is an MCAP file moments are extracted from.
+type momentSource struct {
+	filename string
+	open     func(ctx context.Context) (io.ReadCloser, error)
+}
+
+func localMcapSource(path string) momentSource {
+	return momentSource{
+		filename: filepath.Base(path),
+		open: func(context.Context) (io.ReadCloser, error) {
+			return os.Open(path)
+		},
+	}
+}
+
+// recordMcapSources returns the MCAP files of the record, or only the given
+// files if there are any.
+func recordMcapSources(ctx context.Context, pm *config.ProfileManager, recordName *name.Record, only []string) ([]momentSource, error) {
+	recordFiles, err := pm.RecordCli().ListAllFilesWithFilter(ctx, recordName, "recursive=\"true\"")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	var sources []momentSource
+	for _, f := range recordFiles {
+		if len(only) > 0 && !lo.Contains(only, f.Filename) {
+			continue
+		}
+		if !isMcapFile(f.Filename) {
+			if len(only) > 0 {
+				return nil, fmt.Errorf("%s is not an MCAP file", f.Filename)
+			}
+			continue
+		}
+		fileName := f.Name
+		sources = append(sources, momentSource{
+			filename: f.Filename,
+			open: func(ctx context.Context) (io.ReadCloser, error) {
+				downloadUrl, err := pm.FileCli().GenerateFileDownloadUrl(ctx, fileName)
+				if err != nil {
+					return nil, err
+				}
+				return cmd_utils.OpenDownloadUrl(ctx, downloadUrl)
+			},
+		})
+	}
+
+	for _, filename := range only {
+		if !lo.ContainsBy(sources, func(s momentSource) bool { return s.filename == filename }) {
+			return nil, fmt.Errorf("file %s not found in record", filename)
+		}
+	}
+	if len(sources) == 0 {
+		return nil, errors.New("record has no MCAP file")
+	}
+	return sources, nil
+}
+
+// momentExtractStats counts the messages read on the extracted topics.
+type momentExtractStats struct {
+	messages int
+	skipped  int
+}
+
+// extract reads the file in one pass and returns the moments of the messages
+// of topics.
+func (s momentSource) extract(ctx context.Context, topics []string, fields *momentFields, stats *momentExtractStats) ([]*openv1alpha1resource.Event, error) {
+	rc, err := s.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	r, err := mcap.NewReader(rc)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	decoder := mcap.NewDecoder()
+
+	var events []*openv1alpha1resource.Event
+	for {
+		m, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return events, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if !lo.Contains(topics, m.Channel.Topic) {
+			continue
+		}
+		stats.messages++
+
+		v, err := decoder.Decode(m)
+		if err != nil {
+			return nil, err
+		}
+		e, err := fields.event(m, v)
+		if err != nil {
+			return nil, fmt.Errorf("message %d of %s at %s: %w", m.Sequence, m.Channel.Topic, m.LogTime.In(time.Local).Format(time.RFC3339Nano), err)
+		}
+		if e == nil {
+			stats.skipped++
+			continue
+		}
+		e.CustomizedFields = map[string]string{"file": s.filename, "topic": m.Channel.Topic}
+		events = append(events, e)
+	}
+}
+
+// momentFields maps the fields of decoded messages to moments.
+type momentFields struct {
+	title       string
+	time        string
+	duration    string
+	description string
+	// loc is the time zone of time strings without an offset.
+	loc *time.Location
+}
+
+// event returns the moment of a message, or nil if it has no title.
+func (f *momentFields) event(m *mcap.Message, v map[string]any) (*openv1alpha1resource.Event, error) {
+	raw, ok := lookupField(v, f.title)
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	title, err := scalarString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", f.title, err)
+	}
+	if title == "" {
+		return nil, nil
+	}
+
+	e := &openv1alpha1resource.Event{
+		DisplayName: title,
+		TriggerTime: timestamppb.New(m.LogTime),
+		Duration:    durationpb.New(time.Second),
+	}
+	if f.time != "" {
+		raw, ok := lookupField(v, f.time)
+		if !ok {
+			return nil, fmt.Errorf("no %s field", f.time)
+		}
+		trigger, err := fieldTime(raw, f.loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", f.time, err)
+		}
+		e.TriggerTime = timestamppb.New(trigger)
+	}
+	if f.duration != "" {
+		if raw, ok := lookupField(v, f.duration); ok {
+			d, err := fieldDuration(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", f.duration, err)
+			}
+			e.Duration = durationpb.New(d)
+		}
+	}
+	if f.description != "" {
+		if raw, ok := lookupField(v, f.description); ok && raw != nil {
+			if e.Description, err = scalarString(raw); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", f.description, err)
+			}
+		}
+	}
+	return e, nil
+}
+
+// lookupField returns the value at a dotted path such as status.0.name.
+func lookupField(v any, path string) (any, bool) {
+	for _, key := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = node[key]; !ok {
+				return nil, false
+			}
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			v = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func scalarString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool, float64, int64, uint64:
+		return fmt.Sprint(v), nil
+	default:
+		return "", fmt.Errorf("%v is not a string or number", v)
+	}
+}
+
+// fieldNumber converts the numbers of decoded messages to float64.
+func fieldNumber(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// secNsec reads ROS style {sec, nsec} and {sec, nanosec} structs.
+func secNsec(v any) (sec, nsec float64, ok bool) {
+	m, isMap := v.(map[string]any)
+	if !isMap {
+		return 0, 0, false
+	}
+	if sec, ok = fieldNumber(m["sec"]); !ok {
+		return 0, 0, false
+	}
+	if nsec, ok = fieldNumber(m["nsec"]); !ok {
+		nsec, ok = fieldNumber(m["nanosec"])
+	}
+	return sec, nsec, ok
+}
+
+func fieldTime(v any, loc *time.Location) (time.Time, error) {
+	if sec, nsec, ok := secNsec(v); ok {
+		return time.Unix(int64(sec), int64(nsec)), nil
+	}
+	if f, ok := fieldNumber(v); ok {
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(math.Round(frac*1e9))), nil
+	}
+	if s, ok := v.(string); ok {
+		return utils.ParseTime(s, loc)
+	}
+	return time.Time{}, fmt.Errorf("%v is not a time", v)
+}
+
+func fieldDuration(v any) (time.Duration, error) {
+	if sec, nsec, ok := secNsec(v); ok {
+		return time.Duration(sec)*time.Second + time.Duration(nsec), nil
+	}
+	if f, ok := fieldNumber(v); ok {
+		return time.Duration(f * float64(time.Second)), nil
+	}
+	if s, ok := v.(string); ok {
+		return utils.ParseTimeSpan(s)
+	}
+	return 0, fmt.Errorf("%v is not a duration", v)
+}
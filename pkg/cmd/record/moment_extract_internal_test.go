@@ -0,0 +1,140 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/coscene-io/cocli/internal/mcap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMomentFieldsEvent(t *testing.T) {
+	logTime := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	msg := &mcap.Message{Channel: &mcap.Channel{Topic: "/events"}, LogTime: logTime}
+	fields := &momentFields{title: "name", loc: time.UTC}
+
+	t.Run("defaults to the log time and 1s", func(t *testing.T) {
+		e, err := fields.event(msg, map[string]any{"name": "brake"})
+		require.NoError(t, err)
+		assert.Equal(t, "brake", e.DisplayName)
+		assert.True(t, logTime.Equal(e.TriggerTime.AsTime()))
+		assert.Equal(t, time.Second, e.Duration.AsDuration())
+	})
+
+	t.Run("no title", func(t *testing.T) {
+		e, err := fields.event(msg, map[string]any{"level": 1.0})
+		require.NoError(t, err)
+		assert.Nil(t, e)
+	})
+
+	t.Run("nested fields", func(t *testing.T) {
+		f := &momentFields{title: "status.0.name", time: "header.stamp", duration: "span", description: "status.0.message", loc: time.UTC}
+		e, err := f.event(msg, map[string]any{
+			"header": map[string]any{"stamp": map[string]any{"sec": int64(1735725600), "nanosec": uint64(500)}},
+			"span":   map[string]any{"sec": int64(2), "nsec": int64(0)},
+			"status": []any{map[string]any{"name": "motor", "message": "overheat"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "motor", e.DisplayName)
+		assert.Equal(t, "overheat", e.Description)
+		assert.True(t, time.Unix(1735725600, 500).Equal(e.TriggerTime.AsTime()))
+		assert.Equal(t, 2*time.Second, e.Duration.AsDuration())
+	})
+
+	t.Run("time and duration forms", func(t *testing.T) {
+		f := &momentFields{title: "name", time: "at", duration: "for", loc: time.UTC}
+		for _, tt := range []struct {
+			at       any
+			duration any
+			trigger  time.Time
+			expected time.Duration
+		}{
+			{1735725600.25, 1.5, time.Unix(1735725600, 250000000), 1500 * time.Millisecond},
+			{"2025-01-01 10:00:00", "1m30s", logTime, 90 * time.Second},
+			{"2025-01-01T18:00:00+08:00", "00:00:03", logTime, 3 * time.Second},
+		} {
+			e, err := f.event(msg, map[string]any{"name": "x", "at": tt.at, "for": tt.duration})
+			require.NoError(t, err, tt.at)
+			assert.True(t, tt.trigger.Equal(e.TriggerTime.AsTime()), tt.at)
+			assert.Equal(t, tt.expected, e.Duration.AsDuration(), tt.duration)
+		}
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		f := &momentFields{title: "name", time: "at", loc: time.UTC}
+		_, err := f.event(msg, map[string]any{"name": "x", "at": "yesterday"})
+		assert.ErrorContains(t, err, "invalid at")
+		_, err = f.event(msg, map[string]any{"name": "x"})
+		assert.ErrorContains(t, err, "no at field")
+		_, err = fields.event(msg, map[string]any{"name": map[string]any{}})
+		assert.ErrorContains(t, err, "invalid name")
+	})
+}
+
+func TestMomentSourceExtract(t *testing.T) {
+	record := func(b *bytes.Buffer, op byte, content []byte) {
+		b.WriteByte(op)
+		_ = binary.Write(b, binary.LittleEndian, uint64(len(content)))
+		b.Write(content)
+	}
+	str := func(b *bytes.Buffer, s string) {
+		_ = binary.Write(b, binary.LittleEndian, uint32(len(s)))
+		b.WriteString(s)
+	}
+	channel := func(id uint16, topic string) []byte {
+		var c bytes.Buffer
+		_ = binary.Write(&c, binary.LittleEndian, [2]uint16{id, 0})
+		str(&c, topic)
+		str(&c, "json")
+		_ = binary.Write(&c, binary.LittleEndian, uint32(0))
+		return c.Bytes()
+	}
+	message := func(channelID uint16, data string) []byte {
+		var c bytes.Buffer
+		_ = binary.Write(&c, binary.LittleEndian, channelID)
+		_ = binary.Write(&c, binary.LittleEndian, [5]uint32{}) // sequence, log and publish times
+		c.WriteString(data)
+		return c.Bytes()
+	}
+
+	var file bytes.Buffer
+	file.Write(mcap.Magic)
+	record(&file, 0x04, channel(1, "/events"))
+	record(&file, 0x04, channel(2, "/other"))
+	record(&file, 0x05, message(1, `{"name":"brake"}`))
+	record(&file, 0x05, message(2, `{"name":"ignored"}`))
+	record(&file, 0x05, message(1, `{"level":2}`))
+
+	src := momentSource{
+		filename: "log.mcap",
+		open: func(context.Context) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(file.Bytes())), nil
+		},
+	}
+	var stats momentExtractStats
+	events, err := src.extract(context.Background(), []string{"/events"}, &momentFields{title: "name", loc: time.UTC}, &stats)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "brake", events[0].DisplayName)
+	assert.Equal(t, map[string]string{"file": "log.mcap", "topic": "/events"}, events[0].CustomizedFields)
+	assert.Equal(t, momentExtractStats{messages: 2, skipped: 1}, stats)
+}
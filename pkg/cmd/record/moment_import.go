@@ -0,0 +1,238 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/customfield"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// momentImport is a moment of an import file, validated before anything is created.
+type momentImport struct {
+	event *openv1alpha1resource.Event
+	// customInputs are the key=value custom field inputs, resolved on creation.
+	customInputs []string
+}
+
+func NewMomentImportCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug = ""
+		parallel    = 0
+		createTask  = false
+		assigner    = ""
+		assignee    = ""
+		syncTask    = false
+		timeFlags   = momentTimeFlags{}
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <record-resource-name/id> <moments-file> [-p <working-project-slug>] [--tz <zone>] [--relative-to <file>] [--parallel <n>] [--create-task] [-a <assigner>] [-e <assignee>] [-S]",
+		Short: "Create moments in a record from a JSON, JSONL or CSV file",
+		Long: `Create moments in a record from a .json, .jsonl or .csv file.
+
+The file holds moments in the shape written by "record moment download": name,
+description, triggerTime, duration, attribute and customFieldValues. CSV files
+use the columns name, description, triggerTime and duration, plus
+attribute.<key> and custom.<field> columns.
+
+triggerTime and duration take the same forms as in "record moment create",
+including offsets from the record start such as +00:12:03.5.
+
+Moments that already exist are left untouched, so an import can be re-run
+safely. Every moment is validated before any of them is created.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			recordName, err := pm.RecordCli().RecordId2Name(cmd.Context(), args[0], proj)
+			if utils.IsConnectErrorWithCode(err, connect.CodeNotFound) {
+				io.Printf("failed to find record: %s in project: %s\n", args[0], proj)
+				return
+			} else if err != nil {
+				log.Fatalf("unable to get record name from %s: %v", args[0], err)
+			}
+
+			moments, err := cmd_utils.LoadMoments(args[1])
+			if err != nil {
+				log.Fatalf("unable to load moments: %v", err)
+			}
+			if len(moments) == 0 {
+				io.Printf("No moments found in %s.\n", args[1])
+				return
+			}
+
+			var resolver *customfield.Resolver
+			if hasMomentCustomFields(moments) {
+				schema, err := pm.CustomFieldCli().GetMomentCustomFieldSchema(cmd.Context(), recordName.Project())
+				if err != nil {
+					log.Fatalf("failed to get moment custom field schema: %v", err)
+				}
+				resolver = customfield.NewResolver(schema, pm.UserCli())
+			}
+
+			parser, err := timeFlags.parser(cmd.Context(), pm, recordName)
+			if err != nil {
+				log.Fatal(err)
+			}
+			imports, err := prepareMomentImports(moments, recordName, parser, resolver)
+			if err != nil {
+				log.Fatalf("invalid moments in %s:\n%v", args[1], err)
+			}
+
+			var created, existed atomic.Int64
+			errs := utils.ParallelFor(imports, parallel, func(_ int, m *momentImport) error {
+				if len(m.customInputs) > 0 {
+					values, err := resolver.Resolve(cmd.Context(), m.customInputs)
+					if err != nil {
+						return err
+					}
+					m.event.CustomFieldValues = values
+				}
+
+				res, err := pm.EventCli().ObtainEvent(cmd.Context(), recordName.Project().String(), m.event)
+				if err != nil {
+					return err
+				}
+				if !res.GetIsNew() {
+					existed.Add(1)
+					return nil
+				}
+				created.Add(1)
+
+				if !createTask {
+					return nil
+				}
+				return upsertMomentTask(cmd.Context(), pm, recordName, res.GetEvent().GetName(), m.event.DisplayName, m.event.Description, assigner, assignee, syncTask)
+			})
+
+			failed := 0
+			for i, err := range errs {
+				if err != nil {
+					failed++
+					log.Errorf("moment #%d %q: %v", i+1, imports[i].event.DisplayName, err)
+				}
+			}
+
+			io.Printf("Imported %d moments to %s: %d created, %d already existed, %d failed.\n",
+				len(imports), recordName.RecordID, created.Load(), existed.Load(), failed)
+			if failed > 0 {
+				log.Fatalf("failed to import %d moments", failed)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().IntVar(&parallel, "parallel", 4, "number of moments created in parallel")
+	cmd.Flags().BoolVar(&createTask, "create-task", false, "create a task for every newly created moment")
+	cmd.Flags().StringVarP(&assigner, "assigner", "a", "", "the assigner of created tasks")
+	cmd.Flags().StringVarP(&assignee, "assignee", "e", "", "the assignee of created tasks")
+	cmd.Flags().BoolVarP(&syncTask, "sync-task", "S", false, "sync created tasks")
+	timeFlags.register(cmd)
+
+	return cmd
+}
+
+func hasMomentCustomFields(moments []*api.Moment) bool {
+	for _, m := range moments {
+		if len(m.CustomFieldValues) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// prepareMomentImports converts moments to events of the record. Every invalid
+// moment is reported, not only the first one. Custom field values are only
+// checked against the schema here; users are resolved when the moment is created.
+func prepareMomentImports(moments []*api.Moment, recordName *name.Record, parser utils.TimeParser, resolver *customfield.Resolver) ([]*momentImport, error) {
+	var (
+		imports []*momentImport
+		errs    []error
+	)
+	for i, m := range moments {
+		imp, err := prepareMomentImport(m, recordName, parser, resolver)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("moment #%d %q: %w", i+1, m.Name, err))
+			continue
+		}
+		imports = append(imports, imp)
+	}
+	return imports, errors.Join(errs...)
+}
+
+func prepareMomentImport(m *api.Moment, recordName *name.Record, parser utils.TimeParser, resolver *customfield.Resolver) (*momentImport, error) {
+	if m.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	trigger, err := parser.Parse(m.TriggerTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid triggerTime: %w", err)
+	}
+	duration := time.Second
+	if m.Duration != "" {
+		if duration, err = utils.ParseTimeSpan(m.Duration); err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
+		}
+	}
+
+	imp := &momentImport{
+		event: &openv1alpha1resource.Event{
+			DisplayName:      m.Name,
+			Description:      m.Description,
+			TriggerTime:      timestamppb.New(trigger),
+			Duration:         durationpb.New(duration),
+			CustomizedFields: m.Attribute,
+			Record:           recordName.String(),
+		},
+	}
+
+	if len(m.CustomFieldValues) > 0 {
+		values := make(map[string]any)
+		for _, kv := range m.CustomFieldValues {
+			for k, v := range kv {
+				if _, ok := values[k]; ok {
+					return nil, fmt.Errorf("custom field %q is set more than once", k)
+				}
+				values[k] = v
+			}
+		}
+		if imp.customInputs, err = resolver.ValuesToInputs(values); err != nil {
+			return nil, err
+		}
+	}
+	return imp, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"testing"
+	"time"
+
+	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/customfield"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareMomentImports(t *testing.T) {
+	recordName := &name.Record{ProjectID: "p1", RecordID: "r1"}
+	resolver := customfield.NewResolver(&commons.CustomFieldSchema{
+		Properties: []*commons.Property{
+			{Id: "prop-text", Name: "severity", Type: &commons.Property_Text{Text: &commons.TextType{}}},
+		},
+	}, nil)
+
+	t.Run("converts moments to events", func(t *testing.T) {
+		imports, err := prepareMomentImports([]*api.Moment{
+			{
+				Name:              "hard brake",
+				TriggerTime:       "2025-01-01T10:00:00.5Z",
+				Duration:          "1.500000000s",
+				Attribute:         map[string]string{"speed": "12"},
+				CustomFieldValues: []map[string]any{{"severity": "high"}},
+			},
+			{Name: "lane change", TriggerTime: "1735725600"},
+		}, recordName, utils.TimeParser{Location: time.UTC}, resolver)
+		require.NoError(t, err)
+		require.Len(t, imports, 2)
+
+		e := imports[0].event
+		assert.Equal(t, "hard brake", e.DisplayName)
+		assert.Equal(t, recordName.String(), e.Record)
+		assert.Equal(t, time.Date(2025, 1, 1, 10, 0, 0, 5e8, time.UTC), e.TriggerTime.AsTime())
+		assert.Equal(t, 1500*time.Millisecond, e.Duration.AsDuration())
+		assert.Equal(t, map[string]string{"speed": "12"}, e.CustomizedFields)
+		assert.Equal(t, []string{"severity=high"}, imports[0].customInputs)
+
+		assert.Equal(t, time.Second, imports[1].event.Duration.AsDuration())
+		assert.Empty(t, imports[1].customInputs)
+	})
+
+	t.Run("reports every invalid moment", func(t *testing.T) {
+		_, err := prepareMomentImports([]*api.Moment{
+			{TriggerTime: "1735725600"},
+			{Name: "ok", TriggerTime: "1735725600"},
+			{Name: "bad time", TriggerTime: "yesterday"},
+			{Name: "bad field", TriggerTime: "1735725600", CustomFieldValues: []map[string]any{{"color": "red"}}},
+			{Name: "twice", TriggerTime: "1735725600", CustomFieldValues: []map[string]any{{"severity": "a"}, {"severity": "b"}}},
+		}, recordName, utils.TimeParser{Location: time.UTC}, resolver)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `moment #1 "": name is required`)
+		assert.NotContains(t, err.Error(), "#2")
+		assert.ErrorContains(t, err, `moment #3 "bad time": invalid triggerTime: invalid time "yesterday"`)
+		assert.ErrorContains(t, err, `moment #4 "bad field": unknown custom field "color"`)
+		assert.ErrorContains(t, err, `moment #5 "twice": custom field "severity" is set more than once`)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/mcap"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	momentTzUsage         = "time zone of trigger times without an offset, e.g. Asia/Shanghai (defaults to the local time zone)"
+	momentRelativeToUsage = "file of the record that offsets such as +00:12:03.5 are relative to (defaults to the earliest MCAP file)"
+)
+
+// momentTimeFlags are the flags shared by the commands that take moment times.
+type momentTimeFlags struct {
+	tz         string
+	relativeTo string
+}
+
+func (f *momentTimeFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.tz, "tz", "", momentTzUsage)
+	cmd.Flags().StringVar(&f.relativeTo, "relative-to", "", momentRelativeToUsage)
+}
+
+// parser returns the parser of moment times of the record. Offsets are
+// relative to the start of the --relative-to file, or else to the earliest
+// start of the MCAP files of the record, looked up on first use only.
+func (f *momentTimeFlags) parser(ctx context.Context, pm *config.ProfileManager, recordName *name.Record) (utils.TimeParser, error) {
+	loc, err := momentLocation(f.tz)
+	if err != nil {
+		return utils.TimeParser{}, err
+	}
+	return utils.TimeParser{
+		Location: loc,
+		Start: sync.OnceValues(func() (time.Time, error) {
+			return recordStartTime(ctx, pm, recordName, f.relativeTo)
+		}),
+	}, nil
+}
+
+// momentLocation returns the time zone named by --tz, or the local one.
+func momentLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --tz: %w", err)
+	}
+	return loc, nil
+}
+
+// recordStartTime returns the time of the first message of the relativeTo
+// file, or of the MCAP files of the record if relativeTo is empty.
+func recordStartTime(ctx context.Context, pm *config.ProfileManager, recordName *name.Record, relativeTo string) (time.Time, error) {
+	var files []*openv1alpha1resource.File
+	if relativeTo != "" {
+		f, err := pm.FileCli().GetFile(ctx, name.File{ProjectID: recordName.ProjectID, RecordID: recordName.RecordID, Filename: relativeTo}.String())
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !isMcapFile(f.Filename) {
+			return time.Time{}, fmt.Errorf("%s is not an MCAP file, only MCAP files have a known start time", relativeTo)
+		}
+		files = append(files, f)
+	} else {
+		recordFiles, err := pm.RecordCli().ListAllFilesWithFilter(ctx, recordName, "recursive=\"true\"")
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to list files: %w", err)
+		}
+		for _, f := range recordFiles {
+			if isMcapFile(f.Filename) {
+				files = append(files, f)
+			}
+		}
+		if len(files) == 0 {
+			return time.Time{}, fmt.Errorf("record has no MCAP file to take the start time from, use an absolute time or --relative-to")
+		}
+	}
+
+	var start time.Time
+	for _, f := range files {
+		downloadUrl, err := pm.FileCli().GenerateFileDownloadUrl(ctx, f.Name)
+		if err != nil {
+			return time.Time{}, err
+		}
+		stats, err := mcap.ReadStatistics(cmd_utils.NewUrlReaderAt(ctx, downloadUrl), f.Size)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to read the start time of %s: %w", f.Filename, err)
+		}
+		if start.IsZero() || stats.MessageStartTime.Before(start) {
+			start = stats.MessageStartTime
+		}
+	}
+	return start, nil
+}
+
+func isMcapFile(filename string) bool {
+	return strings.EqualFold(filepath.Ext(filename), ".mcap")
+}
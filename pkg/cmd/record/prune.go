@@ -0,0 +1,275 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	printutils "github.com/coscene-io/cocli/internal/printer/utils"
+	"github.com/coscene-io/cocli/internal/prompts"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// pruneLog is the compliance log written after a prune run.
+type pruneLog struct {
+	Project       string            `json:"project"`
+	ExecutedAt    string            `json:"executedAt"`
+	OlderThan     string            `json:"olderThan"`
+	Labels        []string          `json:"labels,omitempty"`
+	ExcludeLabels []string          `json:"excludeLabels,omitempty"`
+	Search        string            `json:"search,omitempty"`
+	Records       []*pruneLogRecord `json:"records"`
+}
+
+type pruneLogRecord struct {
+	Name       string   `json:"name"`
+	Title      string   `json:"title"`
+	Labels     []string `json:"labels,omitempty"`
+	CreateTime string   `json:"createTime"`
+	ByteSize   int64    `json:"byteSize"`
+	Deleted    bool     `json:"deleted"`
+	Error      string   `json:"error,omitempty"`
+}
+
+func NewPruneCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		projectSlug    = ""
+		olderThanStr   = ""
+		labels         []string
+		excludeLabels  []string
+		search         = ""
+		includeArchive = false
+		yes            = false
+		dryRun         = false
+		parallel       = 0
+		logFile        = ""
+		filterFlags    = &cmd_utils.RecordFilterFlags{}
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "prune --older-than <duration> [-p <working-project-slug>] [--labels <label1,label2>] [-s <search or @saved-search>] [--exclude-labels <label1,label2>] [--created-after <time>] [--creator <nickname>] [--device <device>] [--custom <key=value>...] [--has-file <glob>...] [--include-archive] [--dry-run] [-y] [--log-file <path>]",
+		Short:                 "Delete records older than a retention period",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			olderThan, err := utils.ParseDuration(olderThanStr)
+			if err != nil {
+				log.Fatalf("invalid --older-than: %v", err)
+			}
+			ref, err := cmd_utils.SavedSearchRef(cmd, nil, search)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
+			// Get current profile.
+			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
+			proj, err := pm.ProjectName(cmd.Context(), projectSlug)
+			if err != nil {
+				log.Fatalf("unable to get project name: %v", err)
+			}
+
+			searchOptions := &api.SearchRecordsOptions{
+				Project:        proj,
+				Labels:         labels,
+				IncludeArchive: includeArchive,
+			}
+			if err = cmd_utils.ResolveSelection(cmd.Context(), pm, proj, ref, search, filterFlags, searchOptions); err != nil {
+				log.Fatalf("%v", err)
+			}
+			cutoff := time.Now().Add(-olderThan)
+			if err = applyPruneCutoff(searchOptions, cutoff); err != nil {
+				log.Fatalf("%v", err)
+			}
+			records, err := pm.RecordCli().SearchAll(cmd.Context(), searchOptions)
+			if err != nil {
+				log.Fatalf("unable to search records: %v", err)
+			}
+
+			toPrune := filterPrunableRecords(records, cutoff, excludeLabels)
+			if len(toPrune) == 0 {
+				io.Printf("No records created before %s match the criteria.\n", cutoff.In(time.Local).Format(time.RFC3339))
+				return
+			}
+
+			totalBytes := lo.SumBy(toPrune, func(r *openv1alpha1resource.Record) int64 { return r.ByteSize })
+
+			p, err := printer.Printer("table", &printer.Options{})
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err = p.PrintObj(printable.NewRecord(toPrune, ""), io.Out); err != nil {
+				log.Fatalf("unable to print records: %v", err)
+			}
+			io.Printf("\n%d record(s) created before %s hold %s in total.\n",
+				len(toPrune), cutoff.In(time.Local).Format(time.RFC3339), printutils.FormatBytes(uint64(totalBytes)))
+
+			if dryRun {
+				io.Println("Dry run, no records were deleted.")
+				return
+			}
+
+			// Typed confirmation: a y/n keypress is too easy to hit for a bulk delete.
+			if !yes {
+				expected := strconv.Itoa(len(toPrune))
+				typed := prompts.PromptString(fmt.Sprintf("Type the number of records to delete (%s) to confirm", expected), "")
+				if typed != expected {
+					io.Println("Prune aborted.")
+					return
+				}
+			}
+
+			entries := lo.Map(toPrune, func(r *openv1alpha1resource.Record, _ int) *pruneLogRecord {
+				return &pruneLogRecord{
+					Name:  r.Name,
+					Title: r.Title,
+					Labels: lo.Map(r.Labels, func(l *openv1alpha1resource.Label, _ int) string {
+						return l.DisplayName
+					}),
+					CreateTime: r.CreateTime.AsTime().Format(time.RFC3339),
+					ByteSize:   r.ByteSize,
+				}
+			})
+			errs := utils.ParallelFor(entries, parallel, func(_ int, entry *pruneLogRecord) error {
+				recordName, err := name.NewRecord(entry.Name)
+				if err != nil {
+					return err
+				}
+				return pm.RecordCli().Delete(cmd.Context(), recordName)
+			})
+
+			failed := 0
+			for i, err := range errs {
+				if err != nil {
+					failed++
+					entries[i].Error = err.Error()
+					log.Errorf("failed to delete record %s: %v", entries[i].Name, err)
+					continue
+				}
+				entries[i].Deleted = true
+			}
+
+			if logFile == "" {
+				logFile = fmt.Sprintf("cocli-prune-%s.json", time.Now().Format("20060102T150405"))
+			}
+			if err = writePruneLog(logFile, &pruneLog{
+				Project:       proj.String(),
+				ExecutedAt:    time.Now().Format(time.RFC3339),
+				OlderThan:     olderThanStr,
+				Labels:        labels,
+				ExcludeLabels: excludeLabels,
+				Search:        search,
+				Records:       entries,
+			}); err != nil {
+				log.Errorf("unable to write prune log: %v", err)
+			} else {
+				io.Printf("Prune log written to %s\n", logFile)
+			}
+
+			io.Printf("Deleted %d / %d records.\n", len(entries)-failed, len(entries))
+			if failed > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
+	cmd.Flags().StringVar(&olderThanStr, "older-than", "", "only prune records created before this age (e.g. 90d, 2w, 36h)")
+	cmd.Flags().StringSliceVar(&labels, "labels", []string{}, "only prune records with these labels (comma-separated)")
+	cmd.Flags().StringSliceVar(&excludeLabels, "exclude-labels", []string{}, "never prune records carrying any of these labels (comma-separated)")
+	cmd.Flags().StringVarP(&search, "search", "s", "", `search query, e.g. 'labels:"night" AND created>2026-01-01', JSON Logic (from frontend advanced search) or @<saved-search>`)
+	cmd.Flags().BoolVar(&includeArchive, "include-archive", false, "include archived records")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "skip the typed confirmation")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only list the records that would be deleted")
+	cmd.Flags().IntVarP(&parallel, "parallel", "P", 4, "number of records deleted in parallel")
+	cmd.Flags().StringVar(&logFile, "log-file", "", "path of the JSON log of removed records (default cocli-prune-<timestamp>.json)")
+
+	_ = cmd.MarkFlagRequired("older-than")
+	cmd.MarkFlagsMutuallyExclusive("search", "labels")
+	filterFlags.Register(cmd)
+
+	return cmd
+}
+
+// applyPruneCutoff restricts the search to records created before cutoff,
+// so that the server does not return the whole project. A JSON Logic search
+// ignores the typed filters, so the cutoff and, unless archived records are
+// included or the search already decides on them, the archive condition are
+// added to its conditions instead.
+func applyPruneCutoff(opts *api.SearchRecordsOptions, cutoff time.Time) error {
+	if opts.Search == "" {
+		if opts.CreatedBefore.IsZero() || opts.CreatedBefore.After(cutoff) {
+			opts.CreatedBefore = cutoff
+		}
+		return nil
+	}
+
+	var search any
+	if err := json.Unmarshal([]byte(opts.Search), &search); err != nil {
+		return fmt.Errorf("invalid search JSON: %w", err)
+	}
+	conditions := []any{
+		search,
+		map[string]any{"<": []any{map[string]any{"var": "create_time"}, cutoff.UTC().Format(time.RFC3339)}},
+	}
+	if !opts.IncludeArchive && !strings.Contains(opts.Search, `"isArchived"`) {
+		conditions = append(conditions, map[string]any{"==": []any{map[string]any{"var": "isArchived"}, false}})
+	}
+	data, err := json.Marshal(map[string]any{"and": conditions})
+	if err != nil {
+		return err
+	}
+	opts.Search = string(data)
+	return nil
+}
+
+// filterPrunableRecords keeps the records created before cutoff that carry
+// none of the excluded labels. The server already filters by the cutoff, this
+// guards against searches that do not.
+func filterPrunableRecords(records []*openv1alpha1resource.Record, cutoff time.Time, excludeLabels []string) []*openv1alpha1resource.Record {
+	excluded := mapset.NewSet(excludeLabels...)
+	return lo.Filter(records, func(r *openv1alpha1resource.Record, _ int) bool {
+		if r.CreateTime == nil || !r.CreateTime.AsTime().Before(cutoff) {
+			return false
+		}
+		return !lo.ContainsBy(r.Labels, func(l *openv1alpha1resource.Label) bool {
+			return excluded.Contains(l.DisplayName)
+		})
+	})
+}
+
+func writePruneLog(path string, pl *pruneLog) error {
+	data, err := json.MarshalIndent(pl, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPruneCutoff(t *testing.T) {
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("typed filter", func(t *testing.T) {
+		opts := &api.SearchRecordsOptions{}
+		require.NoError(t, applyPruneCutoff(opts, cutoff))
+		assert.Equal(t, cutoff, opts.CreatedBefore)
+	})
+
+	t.Run("earlier created-before is kept", func(t *testing.T) {
+		earlier := cutoff.Add(-time.Hour)
+		opts := &api.SearchRecordsOptions{CreatedBefore: earlier}
+		require.NoError(t, applyPruneCutoff(opts, cutoff))
+		assert.Equal(t, earlier, opts.CreatedBefore)
+	})
+
+	t.Run("json logic search", func(t *testing.T) {
+		opts := &api.SearchRecordsOptions{Search: `{"in":["l1",{"var":"labels"}]}`}
+		require.NoError(t, applyPruneCutoff(opts, cutoff))
+		assert.JSONEq(t, `{"and":[{"in":["l1",{"var":"labels"}]},{"<":[{"var":"create_time"},"2025-01-01T00:00:00Z"]},{"==":[{"var":"isArchived"},false]}]}`, opts.Search)
+		assert.True(t, opts.CreatedBefore.IsZero())
+	})
+
+	t.Run("json logic search including archived", func(t *testing.T) {
+		opts := &api.SearchRecordsOptions{Search: `{"in":["l1",{"var":"labels"}]}`, IncludeArchive: true}
+		require.NoError(t, applyPruneCutoff(opts, cutoff))
+		assert.JSONEq(t, `{"and":[{"in":["l1",{"var":"labels"}]},{"<":[{"var":"create_time"},"2025-01-01T00:00:00Z"]}]}`, opts.Search)
+	})
+
+	t.Run("json logic search deciding on archived", func(t *testing.T) {
+		opts := &api.SearchRecordsOptions{Search: `{"==":[{"var":"isArchived"},true]}`}
+		require.NoError(t, applyPruneCutoff(opts, cutoff))
+		assert.JSONEq(t, `{"and":[{"==":[{"var":"isArchived"},true]},{"<":[{"var":"create_time"},"2025-01-01T00:00:00Z"]}]}`, opts.Search)
+	})
+}
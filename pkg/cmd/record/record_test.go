@@ -40,9 +40,9 @@ func TestRecordCommand(t *testing.T) {
 
 		// Check all expected subcommands
 		expectedSubcommands := []string{
-			"copy", "create", "delete", "describe", "download",
-			"file", "list", "moment", "move", "update",
-			"upload", "view",
+			"apply", "archive", "copy", "create", "delete", "describe", "diff",
+			"download", "ensure", "file", "list", "moment", "move", "prune",
+			"unarchive", "update", "upload", "view",
 		}
 
 		for _, expected := range expectedSubcommands {
@@ -69,15 +69,29 @@ func TestRecordCommand(t *testing.T) {
 
 		// Check expected flags
 		expectedFlags := map[string]string{
-			"project":         "p",
-			"all":             "",
-			"search":          "s",
-			"keywords":        "",
-			"page":            "",
-			"page-size":       "",
-			"labels":          "",
-			"include-archive": "",
-			"output":          "o",
+			"project":          "p",
+			"all":              "",
+			"search":           "s",
+			"keywords":         "",
+			"page":             "",
+			"page-size":        "",
+			"labels":           "",
+			"include-archive":  "",
+			"output":           "o",
+			"created-after":    "",
+			"created-before":   "",
+			"updated-since":    "",
+			"creator":          "",
+			"device":           "",
+			"custom":           "",
+			"has-file":         "",
+			"watch":            "",
+			"interval":         "",
+			"exec":             "",
+			"exec-concurrency": "",
+			"state-file":       "",
+			"sort-by":          "",
+			"desc":             "",
 		}
 
 		for flag, shorthand := range expectedFlags {
@@ -99,7 +113,7 @@ func TestRecordCommand(t *testing.T) {
 		require.NoError(t, err)
 
 		// Check expected flags
-		flags := []string{"project", "title", "description", "labels", "thumbnail", "output"}
+		flags := []string{"project", "title", "description", "labels", "thumbnail", "output", "no-create-labels", "device", "custom-file"}
 		for _, flag := range flags {
 			f := createCmd.Flag(flag)
 			assert.NotNil(t, f, "Flag --%s not found", flag)
@@ -110,6 +124,20 @@ func TestRecordCommand(t *testing.T) {
 			createCmd.MarkFlagRequired("title") == nil)
 	})
 
+	t.Run("Copy command flags", func(t *testing.T) {
+		cfgPath := setupTestConfig(t)
+		var buf bytes.Buffer
+		io := iostreams.Test(nil, &buf, &buf)
+		cmd := record.NewRootCommand(&cfgPath, io, config.Provide)
+
+		copyCmd, _, err := cmd.Find([]string{"copy"})
+		require.NoError(t, err)
+
+		for _, flag := range []string{"project", "dst-project", "force", "to-profile", "parallel", "part-size", "response-timeout"} {
+			assert.NotNil(t, copyCmd.Flag(flag), "Flag --%s not found", flag)
+		}
+	})
+
 	t.Run("Upload command structure", func(t *testing.T) {
 		cfgPath := setupTestConfig(t)
 		var buf bytes.Buffer
@@ -125,7 +153,7 @@ func TestRecordCommand(t *testing.T) {
 		// Check flags
 		flags := []string{
 			"include-hidden", "project", "dir", "parallel",
-			"part-size", "response-timeout", "no-tty", "tty",
+			"part-size", "response-timeout", "no-tty", "tty", "dedupe", "dedupe-from",
 		}
 		for _, flag := range flags {
 			f := uploadCmd.Flag(flag)
@@ -166,7 +194,7 @@ func TestRecordCommand(t *testing.T) {
 		require.NoError(t, err)
 
 		// Check file has subcommands
-		expectedFileSubcommands := []string{"list", "download", "delete", "copy", "move"}
+		expectedFileSubcommands := []string{"list", "download", "delete", "copy", "move", "mv", "tree", "copy-to-project"}
 		for _, expected := range expectedFileSubcommands {
 			found := false
 			for _, sub := range fileCmd.Commands() {
@@ -189,7 +217,7 @@ func TestRecordCommand(t *testing.T) {
 		require.NoError(t, err)
 
 		// Check moment has subcommands
-		expectedMomentSubcommands := []string{"create", "list"}
+		expectedMomentSubcommands := []string{"create", "list", "import", "update", "delete", "extract"}
 		for _, expected := range expectedMomentSubcommands {
 			found := false
 			for _, sub := range momentCmd.Commands() {
@@ -27,15 +27,21 @@ func NewRootCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 		Short: "Work with coScene record.",
 	}
 
+	cmd.AddCommand(NewApplyCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewArchiveCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewCopyCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewCreateCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewDeleteCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewDescribeCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewDiffCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewDownloadCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewEnsureCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewFileCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewListCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewMomentCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewMoveCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewPruneCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewUnarchiveCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewUpdateCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewUploadCommand(cfgPath, io, getProvider))
 	cmd.AddCommand(NewViewCommand(cfgPath, io, getProvider))
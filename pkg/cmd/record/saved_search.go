@@ -0,0 +1,36 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"fmt"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// savedSearchArgs accepts at most one argument, which must be a saved search
+// reference such as @nightly.
+func savedSearchArgs(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("accepts at most 1 saved search reference, received %d", len(args))
+	}
+	if len(args) == 1 {
+		if _, ok := config.ParseSavedSearchRef(args[0]); !ok {
+			return fmt.Errorf("unexpected argument %q, expected a saved search reference such as @nightly", args[0])
+		}
+	}
+	return nil
+}
@@ -17,11 +17,9 @@ package record
 import (
 	"time"
 
-	commons "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
 	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	"connectrpc.com/connect"
 	"github.com/coscene-io/cocli/internal/config"
-	"github.com/coscene-io/cocli/internal/customfield"
 	"github.com/coscene-io/cocli/internal/iostreams"
 	"github.com/coscene-io/cocli/internal/utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
@@ -39,14 +37,16 @@ func NewUpdateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 		appendLabelStrs []string
 		deleteLabelStrs []string
 		customFieldStrs []string
+		customFile      = ""
 		projectSlug     = ""
 		thumbnail       = ""
 		multiOpts       = &upload_utils.UploadManagerOpts{}
 		timeout         time.Duration
+		noCreateLabels  = false
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "update <record-resource-name/id> [-p <working-project-slug>] [-t <title>] [-d <description>] [-l <append-labels>...] [--update-labels <update-labels>...] [--delete-labels <delete-labels>...] [--custom <key=value>...] [-i <thumbnail>]",
+		Use:                   "update <record-resource-name/id> [-p <working-project-slug>] [-t <title>] [-d <description>] [-l <append-labels>...] [--update-labels <update-labels>...] [--delete-labels <delete-labels>...] [--custom <key=value>...] [--custom-file <values.json>] [-i <thumbnail>]",
 		Short:                 "Update record metadata",
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.ExactArgs(1),
@@ -72,6 +72,12 @@ func NewUpdateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 				log.Fatalf("unable to get record name from %s: %v", args[0], err)
 			}
 
+			// Validate custom fields before creating any labels.
+			customFieldValues, err := resolveCustomFieldArgs(cmd.Context(), pm, proj, customFieldStrs, customFile)
+			if err != nil {
+				log.Fatalf("Failed to resolve custom fields: %v", err)
+			}
+
 			labels := make([]*openv1alpha1resource.Label, 0)
 			labelSet := mapset.NewSet[string]()
 			if len(appendLabelStrs) > 0 || len(deleteLabelStrs) > 0 {
@@ -98,7 +104,7 @@ func NewUpdateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 					if labelSet.Contains(labelStr) {
 						continue
 					}
-					appendLabel, err := pm.LabelCli().GetByDisplayNameOrCreate(cmd.Context(), labelStr, recordName.Project())
+					appendLabel, err := resolveLabel(cmd.Context(), pm, labelStr, recordName.Project(), noCreateLabels)
 					if err != nil {
 						log.Fatalf("Failed to get or create label %s: %v", labelStr, err)
 					}
@@ -111,7 +117,7 @@ func NewUpdateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 				labels = make([]*openv1alpha1resource.Label, 0)
 			} else {
 				for _, lbl := range updateLabelStrs {
-					updateLabel, err := pm.LabelCli().GetByDisplayNameOrCreate(cmd.Context(), lbl, recordName.Project())
+					updateLabel, err := resolveLabel(cmd.Context(), pm, lbl, recordName.Project(), noCreateLabels)
 					if err != nil {
 						log.Fatalf("Failed to get or create label %s: %v", lbl, err)
 					}
@@ -119,14 +125,6 @@ func NewUpdateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 				}
 			}
 
-			var customFieldValues []*commons.CustomFieldValue
-			if len(customFieldStrs) > 0 {
-				customFieldValues, err = customfield.ResolveCustomFields(cmd.Context(), pm.CustomFieldCli(), pm.UserCli(), proj, customFieldStrs)
-				if err != nil {
-					log.Fatalf("Failed to resolve custom fields: %v", err)
-				}
-			}
-
 			// Create field mask
 			var paths []string
 			if title != "" {
@@ -180,7 +178,9 @@ func NewUpdateCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 	cmd.Flags().StringSliceVar(&updateLabelStrs, "update-labels", []string{}, "update labels of the record. if contains only one empty string, clear all labels.")
 	cmd.Flags().StringSliceVar(&deleteLabelStrs, "delete-labels", []string{}, "delete labels from the record.")
 	cmd.Flags().StringSliceVarP(&appendLabelStrs, "append-labels", "l", []string{}, "append labels to the record.")
+	cmd.Flags().BoolVar(&noCreateLabels, "no-create-labels", false, "fail instead of creating labels that do not exist")
 	cmd.Flags().StringArrayVar(&customFieldStrs, "custom", []string{}, `custom field values in key=value format (repeatable, e.g. --custom "color=blue")`)
+	cmd.Flags().StringVar(&customFile, "custom-file", "", customFileUsage)
 	cmd.Flags().StringVarP(&projectSlug, "project", "p", "", "the slug of the working project")
 	cmd.Flags().StringVarP(&thumbnail, "thumbnail", "i", "", "thumbnail path of the record.")
 	cmd.Flags().IntVarP(&multiOpts.Threads, "parallel", "P", 4, "number of uploads (could be part) in parallel")
@@ -15,12 +15,15 @@
 package record
 
 import (
+	"context"
+	"fmt"
 	"path/filepath"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/coscene-io/cocli/internal/config"
 	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/name"
 	"github.com/coscene-io/cocli/internal/utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils/upload_utils"
@@ -35,11 +38,21 @@ func NewUploadCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 		targetDir         = ""
 		uploadManagerOpts = &upload_utils.UploadManagerOpts{}
 		timeout           time.Duration
+		dedupe            = false
+		dedupeFrom        []string
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "upload <record-resource-name/id> <path>... [-p <working-project-slug>] [--dir <target-dir>] [-H]",
-		Short:                 "Upload files or directory to a record",
+		Use:   "upload <record-resource-name/id> <path>... [-p <working-project-slug>] [--dir <target-dir>] [-H] [--dedupe] [--dedupe-from <record>...]",
+		Short: "Upload files or directory to a record",
+		Long: `Upload files or directory to a record.
+
+The record must already exist. Create it with record create, ensure or apply,
+which also set the device that produced it with --device.
+
+With --dedupe, files whose content already exists in another record of the
+project are copied on the server instead of uploaded. Existing content is found
+in a local index of earlier uploads, and in the records given by --dedupe-from.`,
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.MinimumNArgs(2),
 		Run: func(cmd *cobra.Command, args []string) {
@@ -74,9 +87,16 @@ func NewUploadCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 				io.Printf("Target directory: %s\n", targetDir)
 			}
 
+			apiOpts := &upload_utils.ApiOpts{SecurityTokenInterface: pm.SecurityTokenCli(), FileInterface: pm.FileCli()}
+			if dedupe || len(dedupeFrom) > 0 {
+				apiOpts.Deduper, err = newDeduper(cmd.Context(), pm, proj, dedupeFrom)
+				if err != nil {
+					log.Fatalf("unable to prepare deduplicated upload: %v", err)
+				}
+			}
+
 			// create minio client and upload manager first.
-			um, err := upload_utils.NewUploadManagerFromConfig(proj, timeout,
-				&upload_utils.ApiOpts{SecurityTokenInterface: pm.SecurityTokenCli(), FileInterface: pm.FileCli()}, uploadManagerOpts)
+			um, err := upload_utils.NewUploadManagerFromConfig(proj, timeout, apiOpts, uploadManagerOpts)
 			if err != nil {
 				log.Fatalf("unable to create upload manager: %v", err)
 			}
@@ -109,7 +129,29 @@ func NewUploadCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func
 	cmd.Flags().BoolVar(&uploadManagerOpts.NoTTY, "no-tty", false, "disable interactive mode for headless environments")
 	cmd.Flags().BoolVar(&uploadManagerOpts.TTY, "tty", false, "force interactive mode even in headless environments")
 
+	cmd.Flags().BoolVar(&dedupe, "dedupe", false, "copy content that already exists in the project instead of uploading it")
+	cmd.Flags().StringSliceVar(&dedupeFrom, "dedupe-from", []string{}, "records searched for existing content, implies --dedupe")
+
 	cmd.MarkFlagsMutuallyExclusive("no-tty", "tty")
 
 	return cmd
 }
+
+// newDeduper loads the local content index and adds the files of the
+// candidate records to it.
+func newDeduper(ctx context.Context, pm *config.ProfileManager, proj *name.Project, candidates []string) (*upload_utils.Deduper, error) {
+	index, err := upload_utils.LoadContentIndex(upload_utils.DefaultContentIndexPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		recordName, err := pm.RecordCli().RecordId2Name(ctx, candidate, proj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get record name from %s: %w", candidate, err)
+		}
+		if err = index.AddRecordFiles(ctx, pm.RecordCli(), recordName); err != nil {
+			return nil, err
+		}
+	}
+	return &upload_utils.Deduper{RecordCli: pm.RecordCli(), FileCli: pm.FileCli(), Index: index}, nil
+}
@@ -0,0 +1,354 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/constants"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	log "github.com/sirupsen/logrus"
+)
+
+// watchOrderBy lists the newest records first, so a poll can stop paging as
+// soon as it reaches the high-water mark.
+const watchOrderBy = "create_time desc"
+
+// watchState is the high-water mark kept on disk between polls and runs: the
+// newest create time seen and the records created at exactly that time.
+type watchState struct {
+	CreateTime time.Time `json:"createTime"`
+	Names      []string  `json:"names"`
+}
+
+// isNew reports whether the record is past the high-water mark.
+func (s *watchState) isNew(r *openv1alpha1resource.Record) bool {
+	t := r.GetCreateTime().AsTime()
+	if t.Before(s.CreateTime) {
+		return false
+	}
+	return !t.Equal(s.CreateTime) || !slices.Contains(s.Names, r.GetName())
+}
+
+// advance moves the high-water mark past the given records.
+func (s *watchState) advance(records []*openv1alpha1resource.Record) {
+	for _, r := range records {
+		t := r.GetCreateTime().AsTime()
+		switch {
+		case t.After(s.CreateTime):
+			s.CreateTime = t
+			s.Names = []string{r.GetName()}
+		case t.Equal(s.CreateTime) && !slices.Contains(s.Names, r.GetName()):
+			s.Names = append(s.Names, r.GetName())
+		}
+	}
+}
+
+func loadWatchState(path string) (*watchState, error) {
+	state := &watchState{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read watch state %s: %w", path, err)
+	}
+	if err = json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse watch state %s: %w", path, err)
+	}
+	return state, nil
+}
+
+func saveWatchState(path string, state *watchState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create watch state dir for %s: %w", path, err)
+	}
+	// Write to a temp file and rename, so an interrupted run never leaves a
+	// truncated high-water mark behind.
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write watch state %s: %w", path, err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// defaultWatchStatePath keys the state file by the watched selection, so
+// different watches of the same project keep separate high-water marks.
+func defaultWatchStatePath(selection string) string {
+	return filepath.Join(constants.DefaultUploaderDirPath, "watch", fmt.Sprintf("%x.json", sha256.Sum256([]byte(selection))))
+}
+
+type recordWatcher struct {
+	pm        *config.ProfileManager
+	io        *iostreams.IOStreams
+	options   *api.SearchRecordsOptions
+	statePath string
+	interval  time.Duration
+
+	// hook, when set, is run through the shell for every new record, with at
+	// most hookConcurrency hooks running at once.
+	hook            *template.Template
+	hookConcurrency int
+
+	// print is called with each batch of new records, oldest first.
+	print func([]*openv1alpha1resource.Record) error
+}
+
+// run polls until the context is cancelled. The first run of a selection
+// only records the newest existing record as the high-water mark.
+func (w *recordWatcher) run(ctx context.Context) error {
+	state, err := loadWatchState(w.statePath)
+	if err != nil {
+		return err
+	}
+	baseline := state.CreateTime.IsZero()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err = w.poll(ctx, state, baseline); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Warnf("unable to poll records: %v", err)
+		} else {
+			baseline = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *recordWatcher) poll(ctx context.Context, state *watchState, baseline bool) error {
+	fresh, err := w.fetchNew(ctx, state, baseline)
+	if err != nil {
+		return err
+	}
+	if len(fresh) == 0 {
+		return nil
+	}
+
+	if !baseline {
+		slices.Reverse(fresh)
+		if err = w.print(fresh); err != nil {
+			return err
+		}
+		w.runHooks(ctx, fresh)
+	}
+
+	state.advance(fresh)
+	return saveWatchState(w.statePath, state)
+}
+
+// fetchNew pages through the selection newest first until it reaches the
+// high-water mark. A baseline poll only needs the newest page.
+func (w *recordWatcher) fetchNew(ctx context.Context, state *watchState, baseline bool) ([]*openv1alpha1resource.Record, error) {
+	opts := *w.options
+	opts.OrderBy = watchOrderBy
+	opts.PageSize = constants.MaxPageSize
+	opts.PageToken = ""
+
+	var fresh []*openv1alpha1resource.Record
+	for {
+		res, err := w.pm.RecordCli().SearchWithPageToken(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		reachedMark := false
+		for _, r := range res.Records {
+			if !state.isNew(r) {
+				reachedMark = r.GetCreateTime().AsTime().Before(state.CreateTime)
+				if reachedMark {
+					break
+				}
+				continue
+			}
+			fresh = append(fresh, r)
+		}
+
+		if baseline || reachedMark || len(res.Records) < int(opts.PageSize) || res.NextPageToken == "" {
+			return fresh, nil
+		}
+		opts.PageToken = res.NextPageToken
+	}
+}
+
+// parseExecHook parses the --exec template. Every value it prints is passed
+// through shellquote, so a record field such as a title holding "$(...)" or
+// ";" reaches the command as a single literal argument.
+func parseExecHook(text string) (*template.Template, error) {
+	t, err := template.New("exec").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{
+			"shellquote":         shellQuote,
+			"shellquoteInSingle": shellQuoteInSingle,
+			"shellquoteInDouble": shellQuoteInDouble,
+		}).
+		Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	for _, tmpl := range t.Templates() {
+		if tmpl.Tree != nil {
+			quoteActions(tmpl.Tree.Root, quoteNone)
+		}
+	}
+	return t, nil
+}
+
+// shellQuoteState is the kind of shell quotes the template text left open.
+type shellQuoteState int
+
+const (
+	quoteNone shellQuoteState = iota
+	quoteSingle
+	quoteDouble
+)
+
+// quoteActions appends to the pipeline of every action that prints the
+// shellquote function matching the quotes open around it, so that values are
+// single words whether or not the template already quotes them. It returns
+// the quote state after node. Branches are assumed to leave the quotes as
+// their first list does.
+func quoteActions(node parse.Node, state shellQuoteState) shellQuoteState {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return state
+		}
+		for _, child := range n.Nodes {
+			state = quoteActions(child, state)
+		}
+	case *parse.TextNode:
+		return scanShellQuotes(n.Text, state)
+	case *parse.ActionNode:
+		// Variable declarations print nothing.
+		if len(n.Pipe.Decl) > 0 {
+			return state
+		}
+		fn := map[shellQuoteState]string{quoteNone: "shellquote", quoteSingle: "shellquoteInSingle", quoteDouble: "shellquoteInDouble"}[state]
+		n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+			NodeType: parse.NodeCommand,
+			Pos:      n.Pos,
+			Args:     []parse.Node{parse.NewIdentifier(fn).SetTree(nil).SetPos(n.Pos)},
+		})
+	case *parse.IfNode:
+		quoteActions(n.ElseList, state)
+		return quoteActions(n.List, state)
+	case *parse.RangeNode:
+		quoteActions(n.ElseList, state)
+		return quoteActions(n.List, state)
+	case *parse.WithNode:
+		quoteActions(n.ElseList, state)
+		return quoteActions(n.List, state)
+	}
+	return state
+}
+
+// scanShellQuotes returns the quote state after the shell text.
+func scanShellQuotes(text []byte, state shellQuoteState) shellQuoteState {
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case state == quoteSingle:
+			if c == '\'' {
+				state = quoteNone
+			}
+		case c == '\\':
+			i++
+		case state == quoteDouble:
+			if c == '"' {
+				state = quoteNone
+			}
+		case c == '\'':
+			state = quoteSingle
+		case c == '"':
+			state = quoteDouble
+		}
+	}
+	return state
+}
+
+// shellQuote renders v as a single-quoted POSIX shell word.
+func shellQuote(v any) string {
+	return "'" + shellQuoteInSingle(v) + "'"
+}
+
+// shellQuoteInSingle renders v for use inside single quotes.
+func shellQuoteInSingle(v any) string {
+	return strings.ReplaceAll(fmt.Sprint(v), "'", `'\''`)
+}
+
+// shellQuoteInDouble renders v for use inside double quotes.
+func shellQuoteInDouble(v any) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "`", "\\`").Replace(fmt.Sprint(v))
+}
+
+func (w *recordWatcher) runHooks(ctx context.Context, records []*openv1alpha1resource.Record) {
+	if w.hook == nil {
+		return
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(w.hookConcurrency, 1))
+	for _, r := range records {
+		var script bytes.Buffer
+		if err := w.hook.Execute(&script, r); err != nil {
+			log.Warnf("unable to render --exec for %s: %v", r.GetName(), err)
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(recordName, script string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			// Hooks write to stderr so stdout stays a clean stream of records.
+			hookCmd := exec.CommandContext(ctx, "sh", "-c", script)
+			hookCmd.Stdout = w.io.ErrOut
+			hookCmd.Stderr = w.io.ErrOut
+			if err := hookCmd.Run(); err != nil {
+				log.Warnf("--exec failed for %s: %v", recordName, err)
+			}
+		}(r.GetName(), script.String())
+	}
+	wg.Wait()
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func watchRecord(name string, createTime time.Time) *openv1alpha1resource.Record {
+	return &openv1alpha1resource.Record{Name: name, CreateTime: timestamppb.New(createTime)}
+}
+
+func TestWatchState(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	state := &watchState{}
+	state.advance([]*openv1alpha1resource.Record{
+		watchRecord("projects/p/records/a", t0),
+		watchRecord("projects/p/records/b", t0.Add(-time.Minute)),
+	})
+	assert.Equal(t, t0, state.CreateTime)
+	assert.Equal(t, []string{"projects/p/records/a"}, state.Names)
+
+	assert.False(t, state.isNew(watchRecord("projects/p/records/a", t0)))
+	assert.False(t, state.isNew(watchRecord("projects/p/records/b", t0.Add(-time.Minute))))
+	assert.True(t, state.isNew(watchRecord("projects/p/records/c", t0)), "same create time, not yet seen")
+	assert.True(t, state.isNew(watchRecord("projects/p/records/d", t0.Add(time.Second))))
+
+	state.advance([]*openv1alpha1resource.Record{watchRecord("projects/p/records/c", t0)})
+	assert.Equal(t, []string{"projects/p/records/a", "projects/p/records/c"}, state.Names)
+
+	path := filepath.Join(t.TempDir(), "watch", "state.json")
+	require.NoError(t, saveWatchState(path, state))
+	loaded, err := loadWatchState(path)
+	require.NoError(t, err)
+	assert.True(t, loaded.CreateTime.Equal(state.CreateTime))
+	assert.Equal(t, state.Names, loaded.Names)
+}
+
+func TestLoadWatchState_Missing(t *testing.T) {
+	state, err := loadWatchState(filepath.Join(t.TempDir(), "missing.json"))
+	require.NoError(t, err)
+	assert.True(t, state.CreateTime.IsZero())
+}
+
+func TestRunHooks_QuotesRecordFields(t *testing.T) {
+	dir := t.TempDir()
+	pwned := filepath.Join(dir, "pwned")
+	title := "$(touch " + pwned + "); touch " + pwned + " 'quoted'"
+
+	hook, err := parseExecHook(`echo {{.Title}} {{if .Title}}{{.Name}}{{end}}`)
+	require.NoError(t, err)
+
+	var errOut bytes.Buffer
+	w := &recordWatcher{
+		io:              iostreams.Test(nil, &bytes.Buffer{}, &errOut),
+		hook:            hook,
+		hookConcurrency: 1,
+	}
+	w.runHooks(context.Background(), []*openv1alpha1resource.Record{{Name: "projects/p/records/a", Title: title}})
+
+	assert.Equal(t, title+" projects/p/records/a\n", errOut.String())
+	_, err = os.Stat(pwned)
+	assert.True(t, os.IsNotExist(err), "record title ran as a command")
+}
+
+func TestRunHooks_QuotesFieldsInsideQuotes(t *testing.T) {
+	dir := t.TempDir()
+	pwned := filepath.Join(dir, "pwned")
+	title := `process "$(touch ` + pwned + `)" it's \` + "`touch " + pwned + "`"
+
+	hook, err := parseExecHook(`printf '%s|%s|%s\n' "{{.Title}}" '{{.Title}}' "name: {{.Name}}"`)
+	require.NoError(t, err)
+
+	var errOut bytes.Buffer
+	w := &recordWatcher{
+		io:              iostreams.Test(nil, &bytes.Buffer{}, &errOut),
+		hook:            hook,
+		hookConcurrency: 1,
+	}
+	w.runHooks(context.Background(), []*openv1alpha1resource.Record{{Name: "projects/p/records/a", Title: title}})
+
+	assert.Equal(t, title+"|"+title+"|name: projects/p/records/a\n", errOut.String())
+	_, err = os.Stat(pwned)
+	assert.True(t, os.IsNotExist(err), "record title ran as a command")
+}
+
+func TestParseExecHook_Invalid(t *testing.T) {
+	_, err := parseExecHook("echo {{.Title")
+	assert.Error(t, err)
+}
@@ -24,11 +24,14 @@ import (
 	"github.com/coscene-io/cocli/internal/constants"
 	"github.com/coscene-io/cocli/internal/iostreams"
 	"github.com/coscene-io/cocli/pkg/cmd/action"
+	"github.com/coscene-io/cocli/pkg/cmd/device"
+	"github.com/coscene-io/cocli/pkg/cmd/label"
 	"github.com/coscene-io/cocli/pkg/cmd/login"
 	"github.com/coscene-io/cocli/pkg/cmd/project"
 	"github.com/coscene-io/cocli/pkg/cmd/record"
 	"github.com/coscene-io/cocli/pkg/cmd/registry"
 	"github.com/coscene-io/cocli/pkg/cmd/role"
+	"github.com/coscene-io/cocli/pkg/cmd/search"
 	"github.com/coscene-io/cocli/pkg/cmd/user"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
 	log "github.com/sirupsen/logrus"
@@ -121,11 +124,14 @@ func NewCommand(io *iostreams.IOStreams, getProvider ProviderGetter) *cobra.Comm
 
 	cmd.AddCommand(NewCompletionCommand())
 	cmd.AddCommand(action.NewRootCommand(&cfgPath, io, getProvider))
+	cmd.AddCommand(device.NewRootCommand(&cfgPath, io, getProvider))
+	cmd.AddCommand(label.NewRootCommand(&cfgPath, io, getProvider))
 	cmd.AddCommand(login.NewRootCommand(&cfgPath, io, getProvider))
 	cmd.AddCommand(project.NewRootCommand(&cfgPath, io, getProvider))
 	cmd.AddCommand(record.NewRootCommand(&cfgPath, io, getProvider))
 	cmd.AddCommand(registry.NewRootCommand(&cfgPath, io, getProvider))
 	cmd.AddCommand(role.NewRootCommand(&cfgPath, io, getProvider))
+	cmd.AddCommand(search.NewRootCommand(&cfgPath, io, getProvider))
 	cmd.AddCommand(user.NewRootCommand(&cfgPath, io, getProvider))
 	cmd.AddCommand(NewUpdateCommand(io))
 
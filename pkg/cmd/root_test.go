@@ -156,10 +156,13 @@ func TestRootCommand(t *testing.T) {
 		expectedCommands := []string{
 			"completion",
 			"action",
+			"device",
+			"label",
 			"login",
 			"project",
 			"registry",
 			"record",
+			"search",
 			"update",
 		}
 
@@ -0,0 +1,50 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/spf13/cobra"
+)
+
+func NewDeleteCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "delete <name>",
+		Short:                 "Delete a saved search",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := getProvider(*cfgPath)
+			pm, profile, err := loadProfile(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if err = profile.DeleteSavedSearch(args[0]); err != nil {
+				return err
+			}
+			if err = cfg.Persist(pm); err != nil {
+				return fmt.Errorf("failed to persist profile manager: %w", err)
+			}
+
+			io.Printf("Saved search %q deleted.\n", args[0])
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/printer"
+	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/spf13/cobra"
+)
+
+func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		outputFormat = ""
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "list [-o <output-format>]",
+		Short:                 "List saved searches of the current profile",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, profile, err := loadProfile(cmd, getProvider(*cfgPath))
+			if err != nil {
+				return err
+			}
+
+			p, err := printer.Printer(outputFormat, &printer.Options{})
+			if err != nil {
+				return err
+			}
+			return p.PrintObj(printable.NewSavedSearches(profile.SavedSearches), io.Out)
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|json|yaml)")
+
+	return cmd
+}
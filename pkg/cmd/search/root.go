@@ -0,0 +1,60 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/spf13/cobra"
+)
+
+func NewRootCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search",
+		Short: "Manage saved record searches, referenced as @<name> in record selections.",
+	}
+
+	cmd.AddCommand(NewDeleteCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewListCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewSaveCommand(cfgPath, io, getProvider))
+	cmd.AddCommand(NewShowCommand(cfgPath, io, getProvider))
+
+	// Saved searches live in the local config file, no server access needed.
+	cmd_utils.DisableAuthCheck(cmd)
+
+	return cmd
+}
+
+// loadProfile loads the on-disk config and the profile whose saved searches
+// are managed: the one named by --profile, or else the current profile.
+func loadProfile(cmd *cobra.Command, cfg config.Provider) (*config.ProfileManager, *config.Profile, error) {
+	pm, err := cfg.GetProfileManager()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get profile manager: %w", err)
+	}
+
+	profileName, _ := cmd.Flags().GetString("profile")
+	if profileName == "" {
+		profileName = pm.CurrentProfile
+	}
+	profile := pm.GetProfile(profileName)
+	if profile == nil {
+		return nil, nil, fmt.Errorf("profile %q not found in config, run `cocli login add` first", profileName)
+	}
+	return pm, profile, nil
+}
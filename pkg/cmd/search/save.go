@@ -0,0 +1,112 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/query"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewSaveCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(string) config.Provider) *cobra.Command {
+	var (
+		saved = &config.SavedSearch{}
+		force = false
+	)
+
+	cmd := &cobra.Command{
+		Use:                   "save <name> [-s <search>] [--labels <label1,label2>] [--keywords <keyword1,keyword2>] [--include-archive] [--created-after <time>] [--created-before <time>] [--updated-since <time>] [--creator <nickname>] [--device <device>] [--custom <key=value>...] [--has-file <glob>...] [-f]",
+		Short:                 "Save a named record selection to the current profile",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			saved.Name = args[0]
+			if err := validateSavedSearch(saved); err != nil {
+				return err
+			}
+
+			cfg := getProvider(*cfgPath)
+			pm, profile, err := loadProfile(cmd, cfg)
+			if err != nil {
+				return err
+			}
+			if _, err = profile.GetSavedSearch(saved.Name); err == nil && !force {
+				return fmt.Errorf("saved search %q already exists, use -f to overwrite", saved.Name)
+			}
+			if err = profile.PutSavedSearch(saved); err != nil {
+				return err
+			}
+			if err = cfg.Persist(pm); err != nil {
+				return fmt.Errorf("failed to persist profile manager: %w", err)
+			}
+
+			io.Printf("Saved search %q to profile %s, use it as @%s.\n", saved.Name, profile.Name, saved.Name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&saved.Search, "search", "s", "", `search query, e.g. 'labels:"night" AND created>2026-01-01', or JSON Logic`)
+	cmd.Flags().StringSliceVar(&saved.Labels, "labels", []string{}, "filter by labels (comma-separated)")
+	cmd.Flags().StringSliceVar(&saved.Keywords, "keywords", []string{}, "filter by keywords in titles (comma-separated)")
+	cmd.Flags().BoolVar(&saved.IncludeArchive, "include-archive", false, "include archived records")
+	cmd.Flags().StringVar(&saved.CreatedAfter, "created-after", "", "only records created at or after this time, relative times are evaluated on use")
+	cmd.Flags().StringVar(&saved.CreatedBefore, "created-before", "", "only records created before this time, relative times are evaluated on use")
+	cmd.Flags().StringVar(&saved.UpdatedSince, "updated-since", "", "only records updated at or after this time, relative times are evaluated on use")
+	cmd.Flags().StringSliceVar(&saved.Creators, "creator", []string{}, "only records created by these users, by nickname (comma-separated)")
+	cmd.Flags().StringSliceVar(&saved.Devices, "device", []string{}, "only records from these devices, by id or resource name (comma-separated)")
+	cmd.Flags().StringArrayVar(&saved.CustomFields, "custom", []string{}, "only records with this custom field value, in key=value format (repeatable)")
+	cmd.Flags().StringArrayVar(&saved.HasFiles, "has-file", []string{}, "only records containing a file matching this path glob (repeatable)")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "overwrite an existing saved search")
+
+	// The other selection flags are ANDed with --search on use. File globs
+	// have no JSON Logic equivalent, so they cannot be.
+	cmd.MarkFlagsMutuallyExclusive("search", "has-file")
+
+	return cmd
+}
+
+// validateSavedSearch checks what can be checked offline, so typos surface
+// when saving rather than on every later use.
+func validateSavedSearch(s *config.SavedSearch) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	if s.Search != "" && !query.IsJSON(s.Search) {
+		if _, err := query.Compile(s.Search, nil); err != nil {
+			return fmt.Errorf("invalid search query: %w", err)
+		}
+	}
+	for flag, value := range map[string]string{
+		"created-after":  s.CreatedAfter,
+		"created-before": s.CreatedBefore,
+		"updated-since":  s.UpdatedSince,
+	} {
+		if value == "" {
+			continue
+		}
+		if _, err := utils.ParseTimeOrAgo(value, time.Now()); err != nil {
+			return fmt.Errorf("invalid --%s: %w", flag, err)
+		}
+	}
+	if s.Search == "" && !s.HasFlagSelection() {
+		return fmt.Errorf("a saved search needs at least one selection flag")
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/iostreams"
+	"github.com/coscene-io/cocli/internal/testutil"
+	"github.com/coscene-io/cocli/pkg/cmd/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `current-profile: dev
+profiles:
+  - name: dev
+    endpoint: https://openapi.dev.coscene.cn
+    token: tok
+    project: proj
+`
+
+func setupTestConfig(t *testing.T) string {
+	t.Helper()
+	cfgPath := filepath.Join(testutil.TempDir(t), "test-config.yaml")
+	require.NoError(t, os.WriteFile(cfgPath, []byte(testConfig), 0600))
+	return cfgPath
+}
+
+func run(t *testing.T, cfgPath string, args ...string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	io := iostreams.Test(nil, &buf, &buf)
+	cmd := search.NewRootCommand(&cfgPath, io, config.Provide)
+	cmd.SetArgs(args)
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func TestSearchCommand(t *testing.T) {
+	t.Run("Root command structure", func(t *testing.T) {
+		cfgPath := setupTestConfig(t)
+		var buf bytes.Buffer
+		io := iostreams.Test(nil, &buf, &buf)
+		cmd := search.NewRootCommand(&cfgPath, io, config.Provide)
+
+		assert.Equal(t, "search", cmd.Use)
+		assert.NotEmpty(t, cmd.Short)
+
+		for _, expected := range []string{"delete", "list", "save", "show"} {
+			sub, _, err := cmd.Find([]string{expected})
+			require.NoError(t, err)
+			assert.Equal(t, expected, sub.Name())
+			assert.NotEmpty(t, sub.Short, "Command %s should have a short description", expected)
+		}
+	})
+
+	t.Run("Save, show, list and delete", func(t *testing.T) {
+		cfgPath := setupTestConfig(t)
+
+		out, err := run(t, cfgPath, "save", "nightly", "--labels", "night,rain", "--created-after", "7d")
+		require.NoError(t, err)
+		assert.Contains(t, out, "@nightly")
+
+		pm, err := config.Provide(cfgPath).GetProfileManager()
+		require.NoError(t, err)
+		saved, err := pm.GetCurrentProfile().GetSavedSearch("nightly")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"night", "rain"}, saved.Labels)
+		assert.Equal(t, "7d", saved.CreatedAfter)
+
+		out, err = run(t, cfgPath, "show", "nightly")
+		require.NoError(t, err)
+		assert.Contains(t, out, "night,rain")
+
+		out, err = run(t, cfgPath, "list")
+		require.NoError(t, err)
+		assert.Contains(t, out, "nightly")
+
+		_, err = run(t, cfgPath, "save", "nightly", "-s", `labels:"night"`)
+		assert.ErrorContains(t, err, "already exists")
+		_, err = run(t, cfgPath, "save", "nightly", "-s", `labels:"night"`, "-f")
+		require.NoError(t, err)
+
+		_, err = run(t, cfgPath, "delete", "nightly")
+		require.NoError(t, err)
+		_, err = run(t, cfgPath, "show", "nightly")
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("Save validates offline", func(t *testing.T) {
+		cfgPath := setupTestConfig(t)
+
+		_, err := run(t, cfgPath, "save", "empty")
+		assert.ErrorContains(t, err, "at least one selection flag")
+		_, err = run(t, cfgPath, "save", "bad", "-s", "labels night")
+		assert.ErrorContains(t, err, "invalid search query")
+		_, err = run(t, cfgPath, "save", "bad", "--updated-since", "soon")
+		assert.ErrorContains(t, err, "invalid --updated-since")
+		_, err = run(t, cfgPath, "save", "bad", "-s", `labels:"night"`, "--has-file", "*.bag")
+		assert.ErrorContains(t, err, "none of the others can be")
+	})
+
+	t.Run("Save combines flags with a search", func(t *testing.T) {
+		cfgPath := setupTestConfig(t)
+
+		_, err := run(t, cfgPath, "save", "nightly", "--labels", "night", "-s", "created>7d")
+		require.NoError(t, err)
+
+		pm, err := config.Provide(cfgPath).GetProfileManager()
+		require.NoError(t, err)
+		saved, err := pm.GetCurrentProfile().GetSavedSearch("nightly")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"night"}, saved.Labels)
+		assert.Equal(t, "created>7d", saved.Search)
+	})
+}
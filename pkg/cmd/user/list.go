@@ -15,12 +15,17 @@
 package user
 
 import (
+	"context"
+	"strings"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
 	"github.com/coscene-io/cocli/api"
 	"github.com/coscene-io/cocli/internal/config"
 	"github.com/coscene-io/cocli/internal/constants"
 	"github.com/coscene-io/cocli/internal/iostreams"
 	"github.com/coscene-io/cocli/internal/printer"
 	"github.com/coscene-io/cocli/internal/printer/printable"
+	"github.com/coscene-io/cocli/internal/utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -34,10 +39,13 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 		outputFormat = ""
 		pageSize     = 0
 		pageToken    = ""
+		all          = false
+		sortBy       = ""
+		desc         = false
 	)
 
 	cmd := &cobra.Command{
-		Use:                   "list [-p <project-slug>] [--role-code <code>] [--page-size <size>] [--page-token <token>]",
+		Use:                   "list [-p <project-slug>] [--role-code <code>] [--page-size <size>] [--page-token <token>] [--all] [--sort-by <field> [--desc]]",
 		Short:                 "List users in the organization or project",
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.ExactArgs(0),
@@ -46,6 +54,11 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 				log.Fatalf("--page-size must be between 10 and 100")
 			}
 
+			order, err := utils.ParseSortOrder(sortBy, desc, userSortFields)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
 			pm := cmd_utils.ProfileManager(cmd, getProvider, *cfgPath)
 
 			parent := ""
@@ -62,16 +75,26 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 				effectivePageSize = int32(constants.MaxPageSize)
 			}
 
-			result, err := pm.UserCli().ListUsers(cmd.Context(), &api.ListUsersOptions{
+			opts := &api.ListUsersOptions{
 				Parent:    parent,
 				PageSize:  effectivePageSize,
 				PageToken: pageToken,
 				RoleCode:  roleCode,
-			})
+			}
+			var result *api.ListUsersResult
+			if all {
+				result, err = listAllUsers(cmd.Context(), pm.UserCli(), opts)
+			} else {
+				result, err = pm.UserCli().ListUsers(cmd.Context(), opts)
+			}
 			if err != nil {
 				log.Fatalf("unable to list users: %v", err)
 			}
 
+			// ListUsers has no order_by, so a page is sorted on its own; only
+			// --all orders across every user.
+			utils.SortBy(result.Users, order, userCompare)
+
 			format, tableOpts := userTableOpts(verbose, outputFormat)
 			p, err := printer.Printer(format, &printer.Options{TableOpts: tableOpts})
 			if err != nil {
@@ -95,6 +118,44 @@ func NewListCommand(cfgPath *string, io *iostreams.IOStreams, getProvider func(s
 	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "output format (table|wide|json|yaml)")
 	cmd.Flags().IntVar(&pageSize, "page-size", 0, "number of users per page (10-100)")
 	cmd.Flags().StringVar(&pageToken, "page-token", "", "page token for pagination (get from previous response)")
+	cmd.Flags().BoolVar(&all, "all", false, "list all users, following every page")
+	cmd.Flags().StringVar(&sortBy, "sort-by", "", "sort users by field ("+strings.Join(userSortFields, "|")+"), within the page unless --all is set")
+	cmd.Flags().BoolVar(&desc, "desc", false, "sort in descending order, requires --sort-by")
+
+	cmd.MarkFlagsMutuallyExclusive("all", "page-size")
+	cmd.MarkFlagsMutuallyExclusive("all", "page-token")
 
 	return cmd
 }
+
+// listAllUsers follows the page tokens of ListUsers and returns every user.
+func listAllUsers(ctx context.Context, userCli api.UserInterface, opts *api.ListUsersOptions) (*api.ListUsersResult, error) {
+	pageOpts := *opts
+	pageOpts.PageSize = int32(constants.MaxPageSize)
+	pageOpts.PageToken = ""
+
+	ret := &api.ListUsersResult{}
+	for {
+		res, err := userCli.ListUsers(ctx, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		ret.Users = append(ret.Users, res.Users...)
+		ret.TotalSize = res.TotalSize
+		if len(res.Users) < int(pageOpts.PageSize) || res.NextPageToken == "" {
+			return ret, nil
+		}
+		pageOpts.PageToken = res.NextPageToken
+	}
+}
+
+var userSortFields = []string{utils.SortCreateTime, utils.SortTitle}
+
+var userCompare = map[string]func(a, b *openv1alpha1resource.User) int{
+	utils.SortCreateTime: func(a, b *openv1alpha1resource.User) int {
+		return a.GetCreateTime().AsTime().Compare(b.GetCreateTime().AsTime())
+	},
+	utils.SortTitle: func(a, b *openv1alpha1resource.User) int {
+		return strings.Compare(a.GetNickname(), b.GetNickname())
+	},
+}
@@ -67,7 +67,7 @@ func TestUserCommand(t *testing.T) {
 		listCmd, _, err := cmd.Find([]string{"list"})
 		require.NoError(t, err)
 
-		for _, flag := range []string{"project", "role-code", "verbose", "output", "page-size", "page-token"} {
+		for _, flag := range []string{"project", "role-code", "verbose", "output", "page-size", "page-token", "all", "sort-by", "desc"} {
 			assert.NotNil(t, listCmd.Flag(flag), "Flag --%s not found", flag)
 		}
 	})
@@ -0,0 +1,155 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd_utils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/pkg/errors"
+)
+
+const (
+	momentAttributeColumnPrefix   = "attribute."
+	momentCustomFieldColumnPrefix = "custom."
+)
+
+// LoadMoments reads moments in the shape written by SaveMomentsJson from a
+// .json, .jsonl (or .ndjson) or .csv file. A JSON file holds either the
+// {"moments": [...]} object written by SaveMomentsJson or a plain array.
+// CSV columns are name, description, triggerTime and duration, plus
+// attribute.<key> and custom.<field> columns for attributes and custom
+// field values. Empty cells are ignored.
+func LoadMoments(path string) ([]*api.Moment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var moments []*api.Moment
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		moments, err = parseMomentsJson(data)
+	case ".jsonl", ".ndjson":
+		moments, err = parseMomentsJsonl(data)
+	case ".csv":
+		moments, err = parseMomentsCsv(data)
+	default:
+		return nil, errors.Errorf("unsupported moments file extension %q, expected .json, .jsonl or .csv", ext)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %s", path)
+	}
+	return moments, nil
+}
+
+func parseMomentsJson(data []byte) ([]*api.Moment, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		var moments []*api.Moment
+		if err := json.Unmarshal(data, &moments); err != nil {
+			return nil, err
+		}
+		return moments, nil
+	}
+
+	var file struct {
+		Moments []*api.Moment `json:"moments"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	return file.Moments, nil
+}
+
+func parseMomentsJsonl(data []byte) ([]*api.Moment, error) {
+	var moments []*api.Moment
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var m api.Moment
+		if err := json.Unmarshal(line, &m); err != nil {
+			return nil, errors.Wrapf(err, "line %d", lineNo)
+		}
+		moments = append(moments, &m)
+	}
+	return moments, scanner.Err()
+}
+
+func parseMomentsCsv(data []byte) ([]*api.Moment, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read header")
+	}
+	for _, column := range header {
+		switch {
+		case column == "name", column == "description", column == "triggerTime", column == "duration":
+		case strings.HasPrefix(column, momentAttributeColumnPrefix), strings.HasPrefix(column, momentCustomFieldColumnPrefix):
+		default:
+			return nil, errors.Errorf("unknown column %q", column)
+		}
+	}
+
+	var moments []*api.Moment
+	for lineNo := 2; ; lineNo++ {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		m := &api.Moment{Attribute: map[string]string{}}
+		for i, column := range header {
+			value := row[i]
+			if value == "" {
+				continue
+			}
+			switch {
+			case column == "name":
+				m.Name = value
+			case column == "description":
+				m.Description = value
+			case column == "triggerTime":
+				m.TriggerTime = value
+			case column == "duration":
+				m.Duration = value
+			case strings.HasPrefix(column, momentAttributeColumnPrefix):
+				m.Attribute[strings.TrimPrefix(column, momentAttributeColumnPrefix)] = value
+			default:
+				m.CustomFieldValues = append(m.CustomFieldValues, map[string]any{
+					strings.TrimPrefix(column, momentCustomFieldColumnPrefix): value,
+				})
+			}
+		}
+		if m.Name == "" && m.TriggerTime == "" {
+			return nil, errors.Errorf("line %d: name and triggerTime are empty", lineNo)
+		}
+		moments = append(moments, m)
+	}
+	return moments, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd_utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeMomentsFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoadMoments(t *testing.T) {
+	expected := []*api.Moment{
+		{
+			Name:              "hard brake",
+			TriggerTime:       "2025-01-01T10:00:00.5+08:00",
+			Duration:          "1.500000000s",
+			Attribute:         map[string]string{"speed": "12"},
+			CustomFieldValues: []map[string]any{{"severity": "high"}},
+		},
+		{
+			Name:        "lane change",
+			Description: "left",
+			TriggerTime: "1735700000",
+			Attribute:   map[string]string{},
+		},
+	}
+
+	t.Run("round trips SaveMomentsJson", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, SaveMomentsJson(expected, dir))
+
+		moments, err := LoadMoments(filepath.Join(dir, "moments.json"))
+		require.NoError(t, err)
+		assert.Equal(t, expected, moments)
+	})
+
+	t.Run("json array", func(t *testing.T) {
+		moments, err := LoadMoments(writeMomentsFile(t, "moments.json", `[{"name": "a", "triggerTime": "1"}]`))
+		require.NoError(t, err)
+		require.Len(t, moments, 1)
+		assert.Equal(t, "a", moments[0].Name)
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		moments, err := LoadMoments(writeMomentsFile(t, "moments.jsonl",
+			`{"name": "hard brake", "triggerTime": "2025-01-01T10:00:00.5+08:00", "duration": "1.500000000s", "attribute": {"speed": "12"}, "customFieldValues": [{"severity": "high"}]}
+
+{"name": "lane change", "description": "left", "triggerTime": "1735700000", "attribute": {}}
+`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, moments)
+	})
+
+	t.Run("jsonl reports the bad line", func(t *testing.T) {
+		_, err := LoadMoments(writeMomentsFile(t, "moments.jsonl", "{\"name\": \"a\"}\n{oops\n"))
+		assert.ErrorContains(t, err, "line 2")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		moments, err := LoadMoments(writeMomentsFile(t, "moments.csv",
+			"name,description,triggerTime,duration,attribute.speed,custom.severity\n"+
+				"hard brake,,2025-01-01T10:00:00.5+08:00,1.500000000s,12,high\n"+
+				"lane change,left,1735700000,,,\n"))
+		require.NoError(t, err)
+		assert.Equal(t, expected, moments)
+	})
+
+	t.Run("csv rejects unknown columns", func(t *testing.T) {
+		_, err := LoadMoments(writeMomentsFile(t, "moments.csv", "name,color\na,b\n"))
+		assert.ErrorContains(t, err, `unknown column "color"`)
+	})
+
+	t.Run("unsupported extension", func(t *testing.T) {
+		_, err := LoadMoments(writeMomentsFile(t, "moments.txt", ""))
+		assert.ErrorContains(t, err, "unsupported moments file extension")
+	})
+}
@@ -40,3 +40,17 @@ func ProfileManager(cmd *cobra.Command, getProvider func(string) config.Provider
 	}
 	return pm
 }
+
+// ProfileManagerFor returns the profile manager of the named profile, falling
+// back to ProfileManager when profile is empty. It serves commands that work
+// across two profiles, e.g. comparing or copying records between deployments.
+func ProfileManagerFor(cmd *cobra.Command, getProvider func(string) config.Provider, cfgPath string, profile string) *config.ProfileManager {
+	if profile == "" {
+		return ProfileManager(cmd, getProvider, cfgPath)
+	}
+	pm, _, err := config.ResolveProfileManager(cmd.Context(), getProvider(cfgPath), profile)
+	if err != nil {
+		log.Fatalf("Failed to resolve profile %q: %v", profile, err)
+	}
+	return pm
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd_utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/customfield"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// RecordFilterFlags are the typed record selection flags shared by the
+// commands that search records. They compile into the AIP-160 filter, so
+// they cannot be combined with a raw JSON Logic --search query.
+type RecordFilterFlags struct {
+	CreatedAfter  string
+	CreatedBefore string
+	UpdatedSince  string
+	Creators      []string
+	Devices       []string
+	CustomFields  []string
+	HasFiles      []string
+}
+
+var RecordFilterFlagNames = []string{"created-after", "created-before", "updated-since", "creator", "device", "custom", "has-file"}
+
+// Register adds the filter flags to cmd. When cmd already defines --search,
+// the flags are marked mutually exclusive with it.
+func (f *RecordFilterFlags) Register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.CreatedAfter, "created-after", "", "only records created at or after this time (RFC3339, date, or a duration ago such as 7d)")
+	cmd.Flags().StringVar(&f.CreatedBefore, "created-before", "", "only records created before this time (RFC3339, date, or a duration ago such as 7d)")
+	cmd.Flags().StringVar(&f.UpdatedSince, "updated-since", "", "only records updated at or after this time (RFC3339, date, or a duration ago such as 7d)")
+	cmd.Flags().StringSliceVar(&f.Creators, "creator", []string{}, "only records created by these users, by nickname (comma-separated)")
+	cmd.Flags().StringSliceVar(&f.Devices, "device", []string{}, "only records from these devices, by id or resource name (comma-separated)")
+	cmd.Flags().StringArrayVar(&f.CustomFields, "custom", []string{}, `only records with this custom field value, in key=value format (repeatable)`)
+	cmd.Flags().StringArrayVar(&f.HasFiles, "has-file", []string{}, `only records containing a file matching this path glob, e.g. "*.mcap" (repeatable)`)
+
+	if cmd.Flags().Lookup("search") != nil {
+		for _, flag := range RecordFilterFlagNames {
+			cmd.MarkFlagsMutuallyExclusive("search", flag)
+		}
+	}
+}
+
+// IsSet reports whether any filter flag was given.
+func (f *RecordFilterFlags) IsSet() bool {
+	return f.CreatedAfter != "" || f.CreatedBefore != "" || f.UpdatedSince != "" ||
+		len(f.Creators) > 0 || len(f.Devices) > 0 || len(f.CustomFields) > 0 || len(f.HasFiles) > 0
+}
+
+// Apply resolves the flags against the project and sets them on opts.
+func (f *RecordFilterFlags) Apply(ctx context.Context, pm *config.ProfileManager, proj *name.Project, opts *api.SearchRecordsOptions) error {
+	now := time.Now()
+	for _, t := range []struct {
+		flag  string
+		value string
+		dst   *time.Time
+	}{
+		{"created-after", f.CreatedAfter, &opts.CreatedAfter},
+		{"created-before", f.CreatedBefore, &opts.CreatedBefore},
+		{"updated-since", f.UpdatedSince, &opts.UpdatedSince},
+	} {
+		if t.value == "" {
+			continue
+		}
+		parsed, err := utils.ParseTimeOrAgo(t.value, now)
+		if err != nil {
+			return fmt.Errorf("invalid --%s: %w", t.flag, err)
+		}
+		*t.dst = parsed
+	}
+
+	for _, nickname := range f.Creators {
+		id, err := customfield.ResolveUserID(ctx, pm.UserCli(), nickname)
+		if err != nil {
+			return fmt.Errorf("invalid --creator: %w", err)
+		}
+		opts.CreatorIDs = append(opts.CreatorIDs, id)
+	}
+
+	for _, device := range f.Devices {
+		opts.DeviceIDs = append(opts.DeviceIDs, strings.TrimPrefix(device, "devices/"))
+	}
+
+	if len(f.CustomFields) > 0 {
+		cfvs, err := customfield.ResolveCustomFields(ctx, pm.CustomFieldCli(), pm.UserCli(), proj, f.CustomFields)
+		if err != nil {
+			return fmt.Errorf("invalid --custom: %w", err)
+		}
+		opts.CustomFields = cfvs
+	}
+
+	opts.HasFiles = f.HasFiles
+	return nil
+}
+
+// Query resolves the flags like Apply and returns them as JSON Logic
+// conditions, for commands that select records by a JSON Logic query only.
+// It returns nil when no flag is set. File globs have no JSON Logic
+// equivalent, so --has-file is rejected.
+func (f *RecordFilterFlags) Query(ctx context.Context, pm *config.ProfileManager, proj *name.Project) (*structpb.Struct, error) {
+	if !f.IsSet() {
+		return nil, nil
+	}
+	if len(f.HasFiles) > 0 {
+		return nil, fmt.Errorf("--has-file cannot be expressed as a JSON Logic query")
+	}
+
+	opts := &api.SearchRecordsOptions{}
+	if err := f.Apply(ctx, pm, proj, opts); err != nil {
+		return nil, err
+	}
+	return filterQuery(opts)
+}
+
+// filterQuery renders the typed filters of opts as JSON Logic.
+func filterQuery(opts *api.SearchRecordsOptions) (*structpb.Struct, error) {
+	compare := func(op, variable string, value any) any {
+		return map[string]any{op: []any{map[string]any{"var": variable}, value}}
+	}
+	anyOf := func(variable string, values []string) any {
+		if len(values) == 1 {
+			return compare("==", variable, values[0])
+		}
+		conds := make([]any, 0, len(values))
+		for _, v := range values {
+			conds = append(conds, compare("==", variable, v))
+		}
+		return map[string]any{"or": conds}
+	}
+
+	var conds []any
+	for _, t := range []struct {
+		op       string
+		variable string
+		value    time.Time
+	}{
+		{">=", "create_time", opts.CreatedAfter},
+		{"<", "create_time", opts.CreatedBefore},
+		{">=", "update_time", opts.UpdatedSince},
+	} {
+		if !t.value.IsZero() {
+			conds = append(conds, compare(t.op, t.variable, t.value.UTC().Format(time.RFC3339)))
+		}
+	}
+	if len(opts.CreatorIDs) > 0 {
+		conds = append(conds, anyOf("creator", opts.CreatorIDs))
+	}
+	if len(opts.DeviceIDs) > 0 {
+		conds = append(conds, anyOf("device", opts.DeviceIDs))
+	}
+	for _, cfv := range opts.CustomFields {
+		variable, value, err := searchVariable(cfv)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, compare("==", variable, value))
+	}
+
+	if len(conds) == 1 {
+		return structpb.NewStruct(conds[0].(map[string]any))
+	}
+	return structpb.NewStruct(map[string]any{"and": conds})
+}
+
+// AndQuery combines JSON Logic queries, skipping nil ones.
+func AndQuery(queries ...*structpb.Struct) *structpb.Struct {
+	var conds []*structpb.Struct
+	for _, q := range queries {
+		if q != nil {
+			conds = append(conds, q)
+		}
+	}
+	switch len(conds) {
+	case 0:
+		return nil
+	case 1:
+		return conds[0]
+	}
+	values := make([]*structpb.Value, 0, len(conds))
+	for _, q := range conds {
+		values = append(values, structpb.NewStructValue(q))
+	}
+	return &structpb.Struct{Fields: map[string]*structpb.Value{
+		"and": structpb.NewListValue(&structpb.ListValue{Values: values}),
+	}}
+}
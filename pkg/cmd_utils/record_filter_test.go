@@ -0,0 +1,65 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd_utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestFilterQuery(t *testing.T) {
+	q, err := filterQuery(&api.SearchRecordsOptions{
+		CreatedAfter: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		CreatorIDs:   []string{"user-1"},
+		DeviceIDs:    []string{"dev-1", "dev-2"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"and": []any{
+		map[string]any{">=": []any{map[string]any{"var": "create_time"}, "2026-01-01T00:00:00Z"}},
+		map[string]any{"==": []any{map[string]any{"var": "creator"}, "user-1"}},
+		map[string]any{"or": []any{
+			map[string]any{"==": []any{map[string]any{"var": "device"}, "dev-1"}},
+			map[string]any{"==": []any{map[string]any{"var": "device"}, "dev-2"}},
+		}},
+	}}, q.AsMap())
+}
+
+func TestFilterQuery_SingleCondition(t *testing.T) {
+	q, err := filterQuery(&api.SearchRecordsOptions{
+		UpdatedSince: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		">=": []any{map[string]any{"var": "update_time"}, "2026-02-01T00:00:00Z"},
+	}, q.AsMap())
+}
+
+func TestAndQuery(t *testing.T) {
+	a, err := structpb.NewStruct(map[string]any{"in": []any{"night", map[string]any{"var": "labels"}}})
+	require.NoError(t, err)
+	b, err := structpb.NewStruct(map[string]any{"==": []any{map[string]any{"var": "device"}, "dev-1"}})
+	require.NoError(t, err)
+
+	assert.Nil(t, AndQuery(nil, nil))
+	assert.Same(t, a, AndQuery(a, nil))
+	assert.Equal(t, map[string]any{"and": []any{a.AsMap(), b.AsMap()}}, AndQuery(a, b).AsMap())
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd_utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// SavedSearchRef returns the saved search referenced by a command argument or
+// the --search value, and fails if other selection flags are combined with it.
+func SavedSearchRef(cmd *cobra.Command, args []string, search string) (string, error) {
+	ref, ok := "", false
+	if len(args) == 1 {
+		ref, ok = config.ParseSavedSearchRef(args[0])
+	} else if search != "" {
+		ref, ok = config.ParseSavedSearchRef(search)
+	}
+	if !ok {
+		return "", nil
+	}
+
+	for _, flag := range append([]string{"labels", "keywords"}, RecordFilterFlagNames...) {
+		if f := cmd.Flags().Lookup(flag); f != nil && f.Changed {
+			return "", fmt.Errorf("saved search @%s cannot be combined with --%s", ref, flag)
+		}
+	}
+	if len(args) == 1 && search != "" {
+		return "", fmt.Errorf("saved search @%s cannot be combined with --search", ref)
+	}
+	return ref, nil
+}
+
+// ApplySavedSearch sets the selection stored under ref on opts, as if its
+// flags had been given on the command line.
+func ApplySavedSearch(ctx context.Context, pm *config.ProfileManager, proj *name.Project, ref string, opts *api.SearchRecordsOptions) error {
+	saved, err := pm.GetCurrentProfile().GetSavedSearch(ref)
+	if err != nil {
+		return err
+	}
+
+	opts.IncludeArchive = opts.IncludeArchive || saved.IncludeArchive
+	if saved.Search != "" {
+		// A JSON Logic search ignores the typed filters, so the saved flags
+		// are ANDed into it instead.
+		withArchive := *saved
+		withArchive.IncludeArchive = opts.IncludeArchive
+		q, err := SavedSearchQuery(ctx, pm, proj, &withArchive)
+		if err != nil {
+			return err
+		}
+		opts.Search, err = SearchJSON(q)
+		return err
+	}
+
+	opts.Labels = saved.Labels
+	opts.Titles = saved.Keywords
+	opts.Search = ""
+	if err = savedFilterFlags(saved).Apply(ctx, pm, proj, opts); err != nil {
+		return fmt.Errorf("saved search @%s: %w", ref, err)
+	}
+	return nil
+}
+
+// SavedSearchQuery returns the whole selection of a saved search as one JSON
+// Logic query, with the selection flags ANDed with the search, for commands
+// that select records by JSON Logic only. Like the typed filters, it skips
+// archived records unless the saved search includes them. It returns nil when
+// the saved search selects every record.
+func SavedSearchQuery(ctx context.Context, pm *config.ProfileManager, proj *name.Project, saved *config.SavedSearch) (*structpb.Struct, error) {
+	selection, err := selectionQuery(ctx, pm, proj, saved.Labels, saved.Keywords, savedFilterFlags(saved))
+	if err != nil {
+		return nil, fmt.Errorf("saved search @%s: %w", saved.Name, err)
+	}
+	if saved.Search == "" {
+		if saved.IncludeArchive {
+			return selection, nil
+		}
+		notArchived, err := structpb.NewStruct(map[string]any{"==": []any{map[string]any{"var": "isArchived"}, false}})
+		if err != nil {
+			return nil, err
+		}
+		return AndQuery(selection, notArchived), nil
+	}
+
+	search, err := CompileSearch(ctx, pm, proj, saved.Search, saved.IncludeArchive)
+	if err != nil {
+		return nil, fmt.Errorf("saved search @%s: %w", saved.Name, err)
+	}
+	return AndQuery(selection, search), nil
+}
+
+// selectionQuery renders the label, keyword and filter flags as JSON Logic,
+// or nil when none is set. As with the typed filters, a record matches when
+// it carries any of the labels and its title contains any of the keywords.
+func selectionQuery(ctx context.Context, pm *config.ProfileManager, proj *name.Project, labels []string, keywords []string, filterFlags *RecordFilterFlags) (*structpb.Struct, error) {
+	anyContains := func(variable string, values []string) (*structpb.Struct, error) {
+		if len(values) == 0 {
+			return nil, nil
+		}
+		conds := make([]any, 0, len(values))
+		for _, v := range values {
+			conds = append(conds, map[string]any{"in": []any{v, map[string]any{"var": variable}}})
+		}
+		if len(conds) == 1 {
+			return structpb.NewStruct(conds[0].(map[string]any))
+		}
+		return structpb.NewStruct(map[string]any{"or": conds})
+	}
+
+	labelIDs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		id, err := labelID(ctx, pm, proj, l)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label %q: %w", l, err)
+		}
+		labelIDs = append(labelIDs, id)
+	}
+	labelQuery, err := anyContains("labels", labelIDs)
+	if err != nil {
+		return nil, err
+	}
+	keywordQuery, err := anyContains("title", keywords)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filterFlags.Query(ctx, pm, proj)
+	if err != nil {
+		return nil, err
+	}
+	return AndQuery(labelQuery, keywordQuery, filter), nil
+}
+
+// savedFilterFlags returns the filter flags stored on a saved search.
+func savedFilterFlags(saved *config.SavedSearch) *RecordFilterFlags {
+	return &RecordFilterFlags{
+		CreatedAfter:  saved.CreatedAfter,
+		CreatedBefore: saved.CreatedBefore,
+		UpdatedSince:  saved.UpdatedSince,
+		Creators:      saved.Creators,
+		Devices:       saved.Devices,
+		CustomFields:  saved.CustomFields,
+		HasFiles:      saved.HasFiles,
+	}
+}
+
+// ResolveSelection fills opts from a saved search reference or, without one,
+// from the filter flags and the --search query.
+func ResolveSelection(ctx context.Context, pm *config.ProfileManager, proj *name.Project, ref string, search string, filterFlags *RecordFilterFlags, opts *api.SearchRecordsOptions) error {
+	if ref != "" {
+		return ApplySavedSearch(ctx, pm, proj, ref, opts)
+	}
+	if err := filterFlags.Apply(ctx, pm, proj, opts); err != nil {
+		return fmt.Errorf("unable to build record filter: %w", err)
+	}
+	if search == "" {
+		return nil
+	}
+	q, err := CompileSearch(ctx, pm, proj, search, opts.IncludeArchive)
+	if err != nil {
+		return err
+	}
+	opts.Search, err = SearchJSON(q)
+	return err
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd_utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedSearchQuery(t *testing.T) {
+	titleContains := func(keyword string) any {
+		return map[string]any{"in": []any{keyword, map[string]any{"var": "title"}}}
+	}
+	notArchived := map[string]any{"==": []any{map[string]any{"var": "isArchived"}, false}}
+
+	t.Run("flags only", func(t *testing.T) {
+		q, err := SavedSearchQuery(context.Background(), nil, nil, &config.SavedSearch{Name: "runs", Keywords: []string{"run", "drive"}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"and": []any{
+			map[string]any{"or": []any{titleContains("run"), titleContains("drive")}},
+			notArchived,
+		}}, q.AsMap())
+	})
+
+	t.Run("flags including archived", func(t *testing.T) {
+		q, err := SavedSearchQuery(context.Background(), nil, nil, &config.SavedSearch{Name: "runs", Keywords: []string{"run"}, IncludeArchive: true})
+		require.NoError(t, err)
+		assert.Equal(t, titleContains("run"), q.AsMap())
+	})
+
+	t.Run("flags and search", func(t *testing.T) {
+		q, err := SavedSearchQuery(context.Background(), nil, nil, &config.SavedSearch{
+			Name:     "runs",
+			Keywords: []string{"run"},
+			Search:   `{"==":[{"var":"device"},"dev-1"]}`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"and": []any{
+			titleContains("run"),
+			map[string]any{"==": []any{map[string]any{"var": "device"}, "dev-1"}},
+		}}, q.AsMap())
+	})
+
+	t.Run("file globs", func(t *testing.T) {
+		_, err := SavedSearchQuery(context.Background(), nil, nil, &config.SavedSearch{Name: "bags", HasFiles: []string{"*.bag"}})
+		assert.ErrorContains(t, err, "saved search @bags: --has-file cannot be expressed")
+	})
+}
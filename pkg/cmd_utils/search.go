@@ -0,0 +1,134 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd_utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/commons"
+	"github.com/coscene-io/cocli/internal/config"
+	"github.com/coscene-io/cocli/internal/customfield"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/query"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// CompileSearch turns a --search value into a JSON Logic query. Raw JSON Logic
+// is passed through unchanged; anything else is compiled as a query, with
+// custom field names and values resolved against the project schema, and
+// labels, creators and devices resolved to their ids. Compiled queries skip
+// archived records unless includeArchive is set or they mention archived.
+func CompileSearch(ctx context.Context, pm *config.ProfileManager, proj *name.Project, search string, includeArchive bool) (*structpb.Struct, error) {
+	if query.IsJSON(search) {
+		s := &structpb.Struct{}
+		if err := protojson.Unmarshal([]byte(search), s); err != nil {
+			return nil, fmt.Errorf("invalid search JSON: %w", err)
+		}
+		return s, nil
+	}
+
+	// The schema is fetched lazily, so queries without custom fields cost no request.
+	var resolver *customfield.Resolver
+	q, err := query.Compile(search, &query.Options{
+		ResolveCustom: func(field, value string) (string, any, error) {
+			if resolver == nil {
+				schema, err := pm.CustomFieldCli().GetRecordCustomFieldSchema(ctx, proj)
+				if err != nil {
+					return "", nil, fmt.Errorf("failed to get custom field schema: %w", err)
+				}
+				resolver = customfield.NewResolver(schema, pm.UserCli())
+			}
+			cfvs, err := resolver.Resolve(ctx, []string{field + "=" + value})
+			if err != nil {
+				return "", nil, err
+			}
+			return searchVariable(cfvs[0])
+		},
+		ResolveLabel: func(displayName string) (string, error) {
+			return labelID(ctx, pm, proj, displayName)
+		},
+		ResolveCreator: func(nickname string) (string, error) {
+			return customfield.ResolveUserID(ctx, pm.UserCli(), nickname)
+		},
+		ResolveDevice: func(device string) (string, error) {
+			deviceName, err := pm.DeviceCli().ResolveName(ctx, device)
+			if err != nil {
+				return "", err
+			}
+			return deviceName.DeviceID, nil
+		},
+		IncludeArchived: includeArchive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid search query: %w", err)
+	}
+	return q, nil
+}
+
+// labelID returns the id of the project label with the given display name.
+func labelID(ctx context.Context, pm *config.ProfileManager, proj *name.Project, displayName string) (string, error) {
+	label, err := pm.LabelCli().GetByDisplayName(ctx, displayName, proj)
+	if err != nil {
+		return "", err
+	}
+	return path.Base(label.Name), nil
+}
+
+// SearchJSON renders a compiled query as the JSON taken by api.SearchRecordsOptions.Search.
+func SearchJSON(q *structpb.Struct) (string, error) {
+	b, err := protojson.Marshal(q)
+	return string(b), err
+}
+
+// ExplainSearch renders a compiled query as indented JSON Logic for --explain.
+func ExplainSearch(q *structpb.Struct) (string, error) {
+	b, err := json.MarshalIndent(q.AsMap(), "", "  ")
+	return string(b), err
+}
+
+// searchVariable returns the JSON Logic variable and stored value of a custom field.
+func searchVariable(cfv *commons.CustomFieldValue) (string, any, error) {
+	variable := "customFields." + cfv.GetProperty().GetId()
+	toAny := func(ids []string) any {
+		if len(ids) == 1 {
+			return ids[0]
+		}
+		return lo.ToAnySlice(ids)
+	}
+
+	switch v := cfv.GetValue().(type) {
+	case *commons.CustomFieldValue_Text:
+		return variable, v.Text.GetValue(), nil
+	case *commons.CustomFieldValue_Number:
+		return variable, v.Number.GetValue(), nil
+	case *commons.CustomFieldValue_Enums:
+		if len(v.Enums.GetIds()) > 0 {
+			return variable, toAny(v.Enums.GetIds()), nil
+		}
+		return variable, v.Enums.GetId(), nil
+	case *commons.CustomFieldValue_Time:
+		return variable, v.Time.GetValue().AsTime().UTC().Format(time.RFC3339), nil
+	case *commons.CustomFieldValue_User:
+		return variable, toAny(v.User.GetIds()), nil
+	default:
+		return "", nil, fmt.Errorf("unsupported custom field type for %s", cfv.GetProperty().GetName())
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload_utils
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/constants"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultContentIndexPath is where the content of uploaded files is indexed
+// between runs for deduplicated uploads.
+var DefaultContentIndexPath = filepath.Join(constants.DefaultUploaderDirPath, "content-index.json")
+
+// ContentIndex maps file content, identified by project, sha256 and size, to a
+// record file known to hold it. Entries may be stale and are verified on use.
+type ContentIndex struct {
+	path    string
+	Entries map[string]string `json:"entries"`
+}
+
+// LoadContentIndex loads the index at path, or returns an empty index if the
+// file does not exist. An empty path gives an index that is never saved.
+func LoadContentIndex(path string) (*ContentIndex, error) {
+	idx := &ContentIndex{path: path, Entries: make(map[string]string)}
+	if path == "" {
+		return idx, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return idx, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "read content index %s", path)
+	}
+	if err = json.Unmarshal(data, idx); err != nil {
+		return nil, errors.Wrapf(err, "parse content index %s", path)
+	}
+	if idx.Entries == nil {
+		idx.Entries = make(map[string]string)
+	}
+	return idx, nil
+}
+
+// AddRecordFiles indexes every file of the record.
+func (idx *ContentIndex) AddRecordFiles(ctx context.Context, recordCli api.RecordInterface, recordName *name.Record) error {
+	files, err := recordCli.ListAllFilesWithFilter(ctx, recordName, "recursive=\"true\"")
+	if err != nil {
+		return errors.Wrapf(err, "list files of %s", recordName)
+	}
+	for _, f := range files {
+		if strings.HasSuffix(f.Filename, "/") {
+			continue
+		}
+		idx.Add(f.Sha256, f.Size, f.Name)
+	}
+	return nil
+}
+
+// Add records that the record file fileName holds the given content.
+func (idx *ContentIndex) Add(sha256 string, size int64, fileName string) {
+	f, err := name.NewFile(fileName)
+	if err != nil || sha256 == "" || size == 0 {
+		return
+	}
+	idx.Entries[contentKey(f.ProjectID, sha256, size)] = fileName
+}
+
+// Lookup returns a record file of the project holding the given content.
+func (idx *ContentIndex) Lookup(projectID string, sha256 string, size int64) (*name.File, bool) {
+	fileName, ok := idx.Entries[contentKey(projectID, sha256, size)]
+	if !ok {
+		return nil, false
+	}
+	f, err := name.NewFile(fileName)
+	if err != nil {
+		return nil, false
+	}
+	return f, true
+}
+
+// Remove forgets the content, e.g. after finding its entry stale.
+func (idx *ContentIndex) Remove(projectID string, sha256 string, size int64) {
+	delete(idx.Entries, contentKey(projectID, sha256, size))
+}
+
+// Save writes the index back to the path it was loaded from.
+func (idx *ContentIndex) Save() error {
+	if idx.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(idx.path), 0755); err != nil {
+		return err
+	}
+	tmp := idx.path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, idx.path)
+}
+
+func contentKey(projectID string, sha256 string, size int64) string {
+	return projectID + "/" + sha256 + "/" + strconv.FormatInt(size, 10)
+}
+
+// Deduper materialises files whose content already exists in another record of
+// the same project with a server-side copy instead of uploading them.
+type Deduper struct {
+	RecordCli api.RecordInterface
+	FileCli   api.FileInterface
+	Index     *ContentIndex
+}
+
+// CopyExisting copies existing content to dstPath of dst and reports whether
+// it did. Any failure is only logged: the caller falls back to uploading.
+func (d *Deduper) CopyExisting(ctx context.Context, sha256 string, size int64, dst *name.Record, dstPath string) bool {
+	src, ok := d.Index.Lookup(dst.ProjectID, sha256, size)
+	if !ok {
+		return false
+	}
+
+	// The index may be stale, make sure the content is still there.
+	f, err := d.FileCli.GetFile(ctx, src.String())
+	if err != nil || f.Sha256 != sha256 || f.Size != size {
+		d.Index.Remove(dst.ProjectID, sha256, size)
+		return false
+	}
+
+	srcRecord := &name.Record{ProjectID: src.ProjectID, RecordID: src.RecordID}
+	if err = d.RecordCli.CopyFilePairs(ctx, srcRecord, dst, []api.FileCopyPair{{Src: src.Filename, Dst: dstPath}}); err != nil {
+		log.Debugf("Unable to copy %s to %s, uploading instead: %v", src, dstPath, err)
+		return false
+	}
+	return true
+}
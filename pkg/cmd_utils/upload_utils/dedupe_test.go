@@ -0,0 +1,62 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload_utils
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.json")
+	idx, err := LoadContentIndex(path)
+	require.NoError(t, err)
+
+	idx.Add("abc", 10, "projects/p1/records/r1/files/raw/a.bag")
+	idx.Add("abc", 0, "projects/p1/records/r1/files/empty")
+	idx.Add("def", 10, "not-a-file-name")
+	require.NoError(t, idx.Save())
+
+	loaded, err := LoadContentIndex(path)
+	require.NoError(t, err)
+	assert.Len(t, loaded.Entries, 1)
+
+	f, ok := loaded.Lookup("p1", "abc", 10)
+	require.True(t, ok)
+	assert.Equal(t, "r1", f.RecordID)
+	assert.Equal(t, "raw/a.bag", f.Filename)
+
+	_, ok = loaded.Lookup("p2", "abc", 10)
+	assert.False(t, ok, "content of another project is not matched")
+	_, ok = loaded.Lookup("p1", "abc", 11)
+	assert.False(t, ok, "content with another size is not matched")
+
+	loaded.Remove("p1", "abc", 10)
+	_, ok = loaded.Lookup("p1", "abc", 10)
+	assert.False(t, ok)
+}
+
+func TestLoadContentIndexMissing(t *testing.T) {
+	idx, err := LoadContentIndex(filepath.Join(t.TempDir(), "missing.json"))
+	require.NoError(t, err)
+	assert.Empty(t, idx.Entries)
+
+	idx, err = LoadContentIndex("")
+	require.NoError(t, err)
+	require.NoError(t, idx.Save())
+}
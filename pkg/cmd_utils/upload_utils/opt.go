@@ -14,6 +14,10 @@ import (
 type ApiOpts struct {
 	api.SecurityTokenInterface
 	api.FileInterface
+
+	// Deduper, if set, copies content that already exists in the project
+	// instead of uploading it. Only used for record uploads.
+	Deduper *Deduper
 }
 
 var (
@@ -0,0 +1,123 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload_utils
+
+import (
+	"context"
+	"io"
+
+	openv1alpha1resource "buf.build/gen/go/coscene-io/coscene-openapi/protocolbuffers/go/coscene/openapi/dataplatform/v1alpha1/resources"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+)
+
+// StreamSource is a file whose content is streamed into the upload parent
+// without being staged on the local disk, e.g. a file of another record.
+type StreamSource struct {
+	// Path is the destination path relative to the upload parent.
+	Path   string
+	Size   int64
+	Sha256 string
+	// Open returns the content of the file. It is called once per upload.
+	Open func(ctx context.Context) (io.ReadCloser, error)
+}
+
+// RunStreams uploads sources into parent, Threads files at a time. Files that
+// already exist with the same sha256 and size are skipped. Large files are sent
+// as multipart uploads holding at most one part per file in memory. Progress is
+// logged per file instead of through the interactive monitor used by Run.
+func (um *UploadManager) RunStreams(ctx context.Context, parent UploadParent, sources []StreamSource) error {
+	pCtx := newParentContextFrom(parent)
+
+	var pending []StreamSource
+	for _, src := range sources {
+		existing, err := um.apiOpts.GetFile(ctx, pCtx.buildResourceName(src.Path))
+		if err == nil && existing.Sha256 == src.Sha256 && existing.Size == src.Size {
+			log.Infof("File %s already uploaded, skipping", src.Path)
+			continue
+		}
+		pending = append(pending, src)
+	}
+
+	uploadUrls := make(map[string]string)
+	for _, batch := range lo.Chunk(pending, processBatchSize) {
+		files := make([]*openv1alpha1resource.File, 0, len(batch))
+		for _, src := range batch {
+			files = append(files, &openv1alpha1resource.File{
+				Name:     pCtx.buildResourceName(src.Path),
+				Filename: src.Path,
+				Sha256:   src.Sha256,
+				Size:     src.Size,
+			})
+		}
+		res, err := um.apiOpts.GenerateFileUploadUrls(ctx, pCtx.parentString, files)
+		if err != nil {
+			return errors.Wrap(err, "unable to generate upload urls")
+		}
+		for k, v := range res {
+			uploadUrls[k] = v
+		}
+	}
+
+	errs := utils.ParallelFor(pending, um.opts.Threads, func(_ int, src StreamSource) error {
+		uploadUrl, ok := uploadUrls[pCtx.buildResourceName(src.Path)]
+		if !ok {
+			return errors.Errorf("no upload url generated for %s", src.Path)
+		}
+		if err := um.streamOne(ctx, src, uploadUrl); err != nil {
+			return err
+		}
+		log.Infof("Uploaded %s", src.Path)
+		return nil
+	})
+
+	failed := 0
+	for i, err := range errs {
+		if err != nil {
+			failed++
+			log.Errorf("Upload failed for %s: %v", pending[i].Path, err)
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("%d of %d files failed to upload", failed, len(pending))
+	}
+	return nil
+}
+
+func (um *UploadManager) streamOne(ctx context.Context, src StreamSource, uploadUrl string) error {
+	bucket, key, tags, err := um.parseUrl(uploadUrl)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse upload url")
+	}
+	if src.Size > int64(maxSinglePutObjectSize) {
+		return errors.Errorf("file size %d exceeds the maximum allowed object size %d", src.Size, maxSinglePutObjectSize)
+	}
+
+	reader, err := src.Open(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to open source")
+	}
+	defer func() { _ = reader.Close() }()
+
+	_, err = um.client.PutObject(ctx, bucket, key, reader, src.Size, minio.PutObjectOptions{
+		UserTags:   tags,
+		PartSize:   um.opts.partSizeUint64,
+		NumThreads: 1,
+	})
+	return err
+}
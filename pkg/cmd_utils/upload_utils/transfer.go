@@ -0,0 +1,96 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload_utils
+
+import (
+	"context"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/api"
+	"github.com/coscene-io/cocli/internal/name"
+	"github.com/coscene-io/cocli/internal/utils"
+	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+)
+
+// TransferPairs maps filenames of the source parent to paths under dir of the
+// destination parent, keeping their relative paths.
+func TransferPairs(filenames []string, dir string) []api.FileCopyPair {
+	dir = strings.Trim(dir, "/")
+	return lo.Map(filenames, func(filename string, _ int) api.FileCopyPair {
+		filename = strings.TrimPrefix(filename, "/")
+		if dir == "" {
+			return api.FileCopyPair{Src: filename, Dst: filename}
+		}
+		return api.FileCopyPair{Src: filename, Dst: path.Join(dir, filename)}
+	})
+}
+
+// serverCopyUnsupported reports whether the server does not implement copying
+// between the two parents, e.g. from a project to a record. Other errors, such
+// as an invalid argument, are real failures and are not retried by streaming.
+func serverCopyUnsupported(err error) bool {
+	return utils.IsConnectErrorWithCode(err, connect.CodeUnimplemented)
+}
+
+// TransferFiles copies files between two parents, each a record or a project.
+// The copy is done on the server side when the server supports the pair of
+// parents, otherwise the content is streamed from presigned download urls to
+// upload urls of dstProject, the project of dst. It reports whether the files
+// were streamed.
+func TransferFiles(ctx context.Context, apiOpts *ApiOpts, opts *UploadManagerOpts, timeout time.Duration,
+	dstProject *name.Project, src UploadParent, dst UploadParent, pairs []api.FileCopyPair) (bool, error) {
+	err := apiOpts.CopyFiles(ctx, src.ParentString(), dst.ParentString(), pairs)
+	if err == nil {
+		return false, nil
+	}
+	if !serverCopyUnsupported(err) {
+		return false, err
+	}
+	log.Debugf("Server side copy from %s to %s is not supported, streaming instead: %v", src.ParentString(), dst.ParentString(), err)
+
+	sources := make([]StreamSource, 0, len(pairs))
+	for _, pair := range pairs {
+		srcName := src.BuildResourceName(pair.Src)
+		f, err := apiOpts.GetFile(ctx, srcName)
+		if err != nil {
+			return true, errors.Wrapf(err, "unable to get file %s", pair.Src)
+		}
+		sources = append(sources, StreamSource{
+			Path:   pair.Dst,
+			Size:   f.Size,
+			Sha256: f.Sha256,
+			Open: func(ctx context.Context) (io.ReadCloser, error) {
+				downloadUrl, err := apiOpts.GenerateFileDownloadUrl(ctx, srcName)
+				if err != nil {
+					return nil, err
+				}
+				return cmd_utils.OpenDownloadUrl(ctx, downloadUrl)
+			},
+		})
+	}
+
+	um, err := NewUploadManagerFromConfig(dstProject, timeout, apiOpts, opts)
+	if err != nil {
+		return true, errors.Wrap(err, "unable to create upload manager")
+	}
+	return true, um.RunStreams(ctx, dst, sources)
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 coScene
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload_utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/coscene-io/cocli/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferPairs(t *testing.T) {
+	filenames := []string{"calib/camera.yaml", "/lidar.yaml"}
+
+	assert.Equal(t, []api.FileCopyPair{
+		{Src: "calib/camera.yaml", Dst: "calib/camera.yaml"},
+		{Src: "lidar.yaml", Dst: "lidar.yaml"},
+	}, TransferPairs(filenames, ""))
+
+	assert.Equal(t, []api.FileCopyPair{
+		{Src: "calib/camera.yaml", Dst: "sensors/calib/camera.yaml"},
+		{Src: "lidar.yaml", Dst: "sensors/lidar.yaml"},
+	}, TransferPairs(filenames, "/sensors/"))
+}
+
+func TestServerCopyUnsupported(t *testing.T) {
+	wrap := func(code connect.Code) error {
+		return fmt.Errorf("failed to copy files: %w", connect.NewError(code, errors.New("boom")))
+	}
+
+	assert.True(t, serverCopyUnsupported(wrap(connect.CodeUnimplemented)))
+	assert.False(t, serverCopyUnsupported(wrap(connect.CodeInvalidArgument)))
+	assert.False(t, serverCopyUnsupported(wrap(connect.CodePermissionDenied)))
+	assert.False(t, serverCopyUnsupported(errors.New("network down")))
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/coscene-io/cocli/internal/name"
 	"github.com/coscene-io/cocli/internal/utils"
 	"github.com/coscene-io/cocli/pkg/cmd_utils"
+	"github.com/dustin/go-humanize"
 	"github.com/getsentry/sentry-go"
 	"github.com/mattn/go-runewidth"
 	"github.com/minio/minio-go/v7"
@@ -74,6 +75,9 @@ const (
 	// PreviouslyUploaded is used to indicate that the file has been uploaded before
 	PreviouslyUploaded
 
+	// Deduplicated is used to indicate that the file content was copied from another record instead of uploaded
+	Deduplicated
+
 	// WaitingForUpload is used to indicate that the file is waiting to be uploaded
 	WaitingForUpload
 
@@ -161,14 +165,17 @@ type UploadManager struct {
 	}
 
 	// other
-	errs    map[string]error
-	isDebug bool
+	errs       map[string]error
+	isDebug    bool
+	savedBytes int64
 }
 
 // parentContext abstracts record/project parent for upload.
 type parentContext struct {
 	parentString      string
 	buildResourceName func(relativePath string) string
+	// record is the parent record, nil for project uploads.
+	record *name.Record
 }
 
 // UploadParent is a public abstraction for upload destination (record or project).
@@ -198,10 +205,14 @@ func (pp ProjectParent) BuildResourceName(relativePath string) string {
 }
 
 func newParentContextFrom(up UploadParent) parentContext {
-	return parentContext{
+	pCtx := parentContext{
 		parentString:      up.ParentString(),
 		buildResourceName: func(relativePath string) string { return up.BuildResourceName(relativePath) },
 	}
+	if rp, ok := up.(RecordParent); ok {
+		pCtx.record = rp.R
+	}
+	return pCtx
 }
 
 func NewUploadManagerFromConfig(proj *name.Project, timeout time.Duration, apiOpts *ApiOpts, opts *UploadManagerOpts) (*UploadManager, error) {
@@ -296,7 +307,8 @@ func (um *UploadManager) Run(ctx context.Context, parent UploadParent, fileOpts
 	}
 	um.uploadWg.Add(len(filesToUpload) + len(fileOpts.AdditionalUploads))
 
-	fileToUploadUrls := um.findAllUploadUrls(ctx, filesToUpload, newParentContextFrom(parent), fileOpts.RelDir(), fileOpts.TargetDir)
+	pCtx := newParentContextFrom(parent)
+	fileToUploadUrls := um.findAllUploadUrls(ctx, filesToUpload, pCtx, fileOpts.RelDir(), fileOpts.TargetDir)
 	for f, v := range fileOpts.AdditionalUploads {
 		fileToUploadUrls[f] = v
 		um.addFile(f)
@@ -355,7 +367,7 @@ func (um *UploadManager) Run(ctx context.Context, parent UploadParent, fileOpts
 
 	// Print final summary in non-interactive mode
 	if um.noTTY {
-		var totalFiles, completedFiles, failedFiles, skippedFiles int
+		var totalFiles, completedFiles, failedFiles, skippedFiles, dedupedFiles int
 		for _, fileInfo := range um.fileInfos {
 			totalFiles++
 			switch fileInfo.Status {
@@ -365,11 +377,13 @@ func (um *UploadManager) Run(ctx context.Context, parent UploadParent, fileOpts
 				failedFiles++
 			case PreviouslyUploaded:
 				skippedFiles++
+			case Deduplicated:
+				dedupedFiles++
 			}
 		}
 
-		log.Infof("Upload completed! Total: %d | Success: %d | Failed: %d | Skipped: %d",
-			totalFiles, completedFiles, failedFiles, skippedFiles)
+		log.Infof("Upload completed! Total: %d | Success: %d | Failed: %d | Skipped: %d | Deduplicated: %d",
+			totalFiles, completedFiles, failedFiles, skippedFiles, dedupedFiles)
 
 		if failedFiles > 0 {
 			log.Warn("Some files failed to upload. Check the error messages above.")
@@ -377,10 +391,43 @@ func (um *UploadManager) Run(ctx context.Context, parent UploadParent, fileOpts
 	}
 
 	um.stopMonitorAndWait()
+	um.updateContentIndex(pCtx)
 
 	return nil
 }
 
+// updateContentIndex remembers the content now present in the record for later
+// deduplicated uploads, and reports the bandwidth saved by this one.
+func (um *UploadManager) updateContentIndex(pCtx parentContext) {
+	d := um.apiOpts.Deduper
+	if d == nil || pCtx.record == nil {
+		return
+	}
+
+	dedupedFiles := 0
+	for _, path := range um.fileList {
+		fi := um.fileInfos[path]
+		switch fi.Status {
+		case Deduplicated:
+			dedupedFiles++
+		case UploadCompleted, PreviouslyUploaded:
+		default:
+			continue
+		}
+		if fi.RemotePath != "" {
+			d.Index.Add(fi.Sha256, fi.Size, pCtx.buildResourceName(fi.RemotePath))
+		}
+	}
+	if err := d.Index.Save(); err != nil {
+		log.Warnf("Unable to save content index: %v", err)
+	}
+
+	if dedupedFiles > 0 {
+		iostreams.System().Printf("Deduplicated %d files, saved %s of upload bandwidth\n",
+			dedupedFiles, humanize.IBytes(uint64(um.savedBytes)))
+	}
+}
+
 // goWithSentry starts a goroutine with sentry error publishing.
 // Also stops the monitor and waits for it to finish if an error occurs.
 func (um *UploadManager) goWithSentry(routineName string, fn func(*sentry.Hub)) {
@@ -903,6 +950,8 @@ func (um *UploadManager) findAllUploadUrls(ctx context.Context, filesToUpload []
 			remotePath = filepath.Join(targetDir, relativePath)
 		}
 
+		um.fileInfos[f].RemotePath = remotePath // Set remote path for display and the content index
+
 		// Existence check
 		resourceName := pCtx.buildResourceName(remotePath)
 		getFileRes, err := um.apiOpts.GetFile(ctx, resourceName)
@@ -915,8 +964,11 @@ func (um *UploadManager) findAllUploadUrls(ctx context.Context, filesToUpload []
 			continue
 		}
 
+		if um.dedupe(ctx, pCtx, f) {
+			continue
+		}
+
 		um.fileInfos[f].Status = WaitingForUpload
-		um.fileInfos[f].RemotePath = remotePath // Set remote path for display
 		files = append(files, &openv1alpha1resource.File{
 			Name:     resourceName,
 			Filename: remotePath,
@@ -961,6 +1013,27 @@ func (um *UploadManager) findAllUploadUrls(ctx context.Context, filesToUpload []
 	return ret
 }
 
+// dedupe copies content already present in the project into the record instead
+// of uploading the file, and reports whether it did.
+func (um *UploadManager) dedupe(ctx context.Context, pCtx parentContext, path string) bool {
+	d := um.apiOpts.Deduper
+	if d == nil || pCtx.record == nil {
+		return false
+	}
+	fi := um.fileInfos[path]
+	if !d.CopyExisting(ctx, fi.Sha256, fi.Size, pCtx.record, fi.RemotePath) {
+		return false
+	}
+
+	fi.Status = Deduplicated
+	um.savedBytes += fi.Size
+	um.uploadWg.Done()
+	if um.noTTY {
+		log.Infof("File %s copied from existing content, skipping upload", fi.RemotePath)
+	}
+	return true
+}
+
 // updateUploadSpeeds computes speed from (uploaded, time) delta for each UploadInProgress file.
 func (um *UploadManager) updateUploadSpeeds() {
 	now := time.Now()
@@ -1102,6 +1175,9 @@ func (um *UploadManager) View() string {
 		case PreviouslyUploaded:
 			s += fmt.Sprintf("%s: %s\n", displayPath, rightAlign("Previously uploaded, skipping"))
 			skipCount++
+		case Deduplicated:
+			s += fmt.Sprintf("%s: %s\n", displayPath, rightAlign("Copied from existing content"))
+			skipCount++
 		case WaitingForUpload:
 			s += fmt.Sprintf("%s: %s\n", displayPath, rightAlign("Waiting for upload"))
 		case UploadCompleted:
@@ -1255,6 +1331,7 @@ func (um *UploadManager) nonInteractiveProgressReporter() {
 			for _, fileInfo := range um.fileInfos {
 				if fileInfo.Status != UploadCompleted &&
 					fileInfo.Status != PreviouslyUploaded &&
+					fileInfo.Status != Deduplicated &&
 					fileInfo.Status != UploadFailed {
 					allDone = false
 					break
@@ -1284,7 +1361,7 @@ func (um *UploadManager) reportNonInteractiveProgress() {
 			completedFiles++
 		case UploadFailed:
 			failedFiles++
-		case PreviouslyUploaded:
+		case PreviouslyUploaded, Deduplicated:
 			skippedFiles++
 		}
 	}
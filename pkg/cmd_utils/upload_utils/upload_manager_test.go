@@ -23,6 +23,7 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/coscene-io/cocli/internal/name"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -121,14 +122,16 @@ func TestUploadManager_View(t *testing.T) {
 				"a": {Path: "a", RemotePath: "a", Status: UploadCompleted},
 				"b": {Path: "b", RemotePath: "b", Status: PreviouslyUploaded},
 				"c": {Path: "c", RemotePath: "c", Status: UploadFailed},
+				"d": {Path: "d", RemotePath: "d", Status: Deduplicated},
 			},
-			fileList:    []string{"a", "b", "c"},
+			fileList:    []string{"a", "b", "c", "d"},
 			windowWidth: 80,
 		}
 		s := um.View()
 		require.Contains(t, s, "Upload completed")
 		require.Contains(t, s, "Previously uploaded")
 		require.Contains(t, s, "Upload failed")
+		require.Contains(t, s, "Copied from existing content")
 		require.NotContains(t, s, "MB/s") // no speed for non-uploading
 	})
 
@@ -140,6 +143,37 @@ func TestUploadManager_View(t *testing.T) {
 	})
 }
 
+func TestUpdateContentIndex(t *testing.T) {
+	idx, err := LoadContentIndex("")
+	require.NoError(t, err)
+	um := &UploadManager{
+		apiOpts: &ApiOpts{Deduper: &Deduper{Index: idx}},
+		fileInfos: map[string]*FileInfo{
+			"/tmp/a": {Path: "/tmp/a", RemotePath: "a", Sha256: "sha-a", Size: 1, Status: UploadCompleted},
+			"/tmp/b": {Path: "/tmp/b", RemotePath: "b", Sha256: "sha-b", Size: 2, Status: PreviouslyUploaded},
+			"/tmp/c": {Path: "/tmp/c", RemotePath: "c", Sha256: "sha-c", Size: 3, Status: UploadFailed},
+		},
+		fileList: []string{"/tmp/a", "/tmp/b", "/tmp/c"},
+	}
+
+	um.updateContentIndex(newParentContextFrom(NewRecordParent(&name.Record{ProjectID: "p1", RecordID: "r1"})))
+
+	for _, tt := range []struct {
+		sha256   string
+		size     int64
+		filename string
+	}{
+		{"sha-a", 1, "a"},
+		{"sha-b", 2, "b"},
+	} {
+		f, ok := idx.Lookup("p1", tt.sha256, tt.size)
+		require.True(t, ok, tt.filename)
+		assert.Equal(t, tt.filename, f.Filename)
+	}
+	_, ok := idx.Lookup("p1", "sha-c", 3)
+	assert.False(t, ok, "failed uploads are not indexed")
+}
+
 // collectProgress drains the progress channel and returns the net sum of UploadedInc.
 func collectProgress(ch chan IncUploadedMsg) int64 {
 	var total int64
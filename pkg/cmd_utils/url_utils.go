@@ -15,6 +15,7 @@
 package cmd_utils
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -80,6 +81,60 @@ func DownloadFileThroughUrl(file string, downloadUrl string, maxRetries int) err
 	return downloadFileThroughUrl(file, downloadUrl, maxRetries, retryWaitMin, retryWaitMax)
 }
 
+// OpenDownloadUrl opens the content behind a pre-signed download url for
+// streaming. The caller closes the returned body.
+func OpenDownloadUrl(ctx context.Context, downloadUrl string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadUrl, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create request for url %v", downloadUrl)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get file from url %v", downloadUrl)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		_ = resp.Body.Close()
+		return nil, errors.Errorf("download url returned HTTP status %s", resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// UrlReaderAt reads ranges of a presigned download url with HTTP range
+// requests, so that parts of large remote files can be read without
+// downloading them.
+type UrlReaderAt struct {
+	ctx context.Context
+	url string
+}
+
+func NewUrlReaderAt(ctx context.Context, downloadUrl string) *UrlReaderAt {
+	return &UrlReaderAt{ctx: ctx, url: downloadUrl}
+}
+
+func (r *UrlReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	req, err := http.NewRequestWithContext(r.ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to create request for url %v", r.url)
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to get file from url %v", r.url)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusPartialContent {
+		return 0, errors.Errorf("range request returned HTTP status %s", resp.Status)
+	}
+	n, err := io.ReadFull(resp.Body, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
 func downloadFileThroughUrl(file string, downloadUrl string, maxRetries int, initialInterval time.Duration, maxInterval time.Duration) error {
 	err := os.MkdirAll(filepath.Dir(file), 0755)
 	if err != nil {
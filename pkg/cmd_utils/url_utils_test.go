@@ -15,10 +15,13 @@
 package cmd_utils
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -83,3 +86,23 @@ func TestDownloadFileThroughUrlTruncatesPartialFileBeforeRetry(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "complete", string(got))
 }
+
+func TestUrlReaderAt(t *testing.T) {
+	content := strings.NewReader("0123456789")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "data", time.Time{}, content)
+	}))
+	defer server.Close()
+
+	r := NewUrlReaderAt(context.Background(), server.URL)
+
+	buf := make([]byte, 4)
+	n, err := r.ReadAt(buf, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, "3456", string(buf))
+
+	n, err = r.ReadAt(buf, 8)
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, "89", string(buf[:n]))
+}